@@ -0,0 +1,254 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Airy returns the Airy functions Ai(x) and Bi(x) and their derivatives; i.e. the two linearly
+// independent solutions of y'' - x y = 0
+//
+//   Computes:
+//     ai, bi           -- Ai(x) and Bi(x)
+//     aiPrime, biPrime -- Ai'(x) and Bi'(x)
+//
+//   With ζ = (2/3) |x|^(3/2), the following methods are used:
+//
+//     |x| ≤ 2.2 : Maclaurin series  Ai = c1 f - c2 g  and  Bi = √3 (c1 f + c2 g)
+//     x > 2.2   : Ai = (1/π) √(x/3) K⅓(ζ) and Ai' = -x/(π√3) K⅔(ζ); Bi and Bi' by the series
+//                 (all terms are positive and there is no cancellation)
+//     x < -2.2  : Ai(-z) = (√z/2) [J⅓(ζ) - Y⅓(ζ)/√3]   Bi(-z) = -(√z/2) [J⅓(ζ)/√3 + Y⅓(ζ)]
+//                 Ai'(-z) = (z/2) [J⅔(ζ) + Y⅔(ζ)/√3]   Bi'(-z) = (z/2) [J⅔(ζ)/√3 - Y⅔(ζ)]
+//
+//   where c1 = Ai(0) = 3^(-2/3)/Γ(2/3) and c2 = -Ai'(0) = 3^(-1/3)/Γ(1/3)
+//
+//   NOTE: (1) the Bessel functions of fractional order are computed by Steed's method (continued
+//             fractions) as in [1] for ζ ≤ 25 and by Hankel's asymptotic expansion otherwise
+//         (2) Bi(x) and Bi'(x) overflow for x ≳ 104
+//
+//   Reference:
+//   [1] Press WH, Teukolsky SA, Vetterling WT, Fnannery BP (2007) Numerical Recipes: The Art of
+//       Scientific Computing. Third Edition. Cambridge University Press. 1235p.
+//
+func Airy(x float64) (ai, bi, aiPrime, biPrime float64) {
+
+	// constants
+	const c1 = 0.355028053887817239260063186004183176397979174199177
+	const c2 = 0.258819403792806798405183560189203963479091138354934
+	sqrt3 := math.Sqrt(3.0)
+
+	// small |x|
+	if math.Abs(x) <= 2.2 {
+		f, g, fp, gp := airySeries(x)
+		ai = c1*f - c2*g
+		bi = sqrt3 * (c1*f + c2*g)
+		aiPrime = c1*fp - c2*gp
+		biPrime = sqrt3 * (c1*fp + c2*gp)
+		return
+	}
+
+	// positive x
+	rootx := math.Sqrt(math.Abs(x))
+	ζ := 2.0 * math.Abs(x) * rootx / 3.0
+	if x > 0 {
+		k13, k23 := besselK13(ζ)
+		ai = rootx * k13 / (math.Pi * sqrt3)
+		aiPrime = -x * k23 / (math.Pi * sqrt3)
+		f, g, fp, gp := airySeries(x)
+		bi = sqrt3 * (c1*f + c2*g)
+		biPrime = sqrt3 * (c1*fp + c2*gp)
+		return
+	}
+
+	// negative x
+	j13, y13 := besselJYfrac(1.0/3.0, ζ)
+	j23, y23 := besselJYfrac(2.0/3.0, ζ)
+	ai = 0.5 * rootx * (j13 - y13/sqrt3)
+	bi = -0.5 * rootx * (j13/sqrt3 + y13)
+	aiPrime = -0.5 * x * (j23 + y23/sqrt3)
+	biPrime = -0.5 * x * (j23/sqrt3 - y23)
+	return
+}
+
+// airySeries computes the Maclaurin series of the auxiliary functions of the Airy functions
+//
+//          ∞    3ᵏ (1/3)ₖ x³ᵏ            ∞    3ᵏ (2/3)ₖ x³ᵏ⁺¹
+//   f  =   Σ   ———————————       g  =   Σ   —————————————     and their derivatives fp and gp
+//         k=0     (3k)!                k=0     (3k+1)!
+//
+func airySeries(x float64) (f, g, fp, gp float64) {
+	x3 := x * x * x
+	p := 1.0 // x³ᵏ / Π (3j-1)(3j)
+	q := 1.0 // x³ᵏ / Π (3j)(3j+1)
+	f, g, fp, gp = 1.0, x, 0.0, 1.0
+	for k := 1; k < 500; k++ {
+		p *= x3 / float64((3*k-1)*(3*k))
+		q *= x3 / float64((3*k)*(3*k+1))
+		if x != 0 {
+			fp += float64(3*k) * p / x
+		}
+		f += p
+		g += q * x
+		gp += float64(3*k+1) * q
+		if math.Abs(p)+math.Abs(q) < 1e-17*(1+math.Abs(f)+math.Abs(g)) {
+			break
+		}
+	}
+	return
+}
+
+// besselK13 computes the modified Bessel functions of the second kind K⅓(x) and K⅔(x) for x ≥ 2
+// using Steed's method for the continued fraction CF2 of Temme (with μ = -1/3) as in [1]
+func besselK13(x float64) (k13, k23 float64) {
+	const μ = -1.0 / 3.0
+	b := 2.0 * (1.0 + x)
+	d := 1.0 / b
+	h, delh := d, d
+	q1, q2 := 0.0, 1.0
+	a1 := 0.25 - μ*μ
+	q, c := a1, a1
+	a := -a1
+	s := 1.0 + q*delh
+	for i := 1; i < 10000; i++ {
+		a -= float64(2 * i)
+		c = -a * c / float64(i+1)
+		qnew := (q1 - b*q2) / a
+		q1 = q2
+		q2 = qnew
+		q += c * qnew
+		b += 2.0
+		d = 1.0 / (b + a*d)
+		delh = (b*d - 1.0) * delh
+		h += delh
+		dels := q * delh
+		s += dels
+		if math.Abs(dels/s) < 1e-16 {
+			break
+		}
+	}
+	h *= a1
+	k13 = math.Sqrt(math.Pi/(2.0*x)) * math.Exp(-x) / s // K[-1/3] = K[1/3]
+	k23 = k13 * (μ + x + 0.5 - h) / x
+	return
+}
+
+// besselJYfrac computes the Bessel functions Jν(x) and Yν(x) for 0 ≤ ν < 1 and x ≥ 2
+//
+//   NOTE: for x ≤ 25, Steed's method with the continued fractions CF1 (for Jν'/Jν) and CF2 (for
+//         p + i q = (Jν' + i Yν')/(Jν + i Yν)) is used as in [1]; otherwise, Hankel's asymptotic
+//         expansion is used
+//
+func besselJYfrac(ν, x float64) (j, y float64) {
+
+	// asymptotic expansion
+	if x > 25 {
+		P, Q := hankelPQ(ν, x)
+		χ := x - (0.5*ν+0.25)*math.Pi
+		sn, cs := math.Sincos(χ)
+		r := math.Sqrt(2.0 / (math.Pi * x))
+		return r * (P*cs - Q*sn), r * (P*sn + Q*cs)
+	}
+
+	// CF1: f = Jν'/Jν by the modified Lentz method; the sign of Jν is tracked by isign
+	const tiny = 1e-300
+	xi := 1.0 / x
+	xi2 := 2.0 * xi
+	isign := 1.0
+	h := ν * xi
+	if h < tiny {
+		h = tiny
+	}
+	b := xi2 * ν
+	d, c := 0.0, h
+	for i := 0; i < 10000; i++ {
+		b += xi2
+		d = b - d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b - 1.0/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1.0 / d
+		del := c * d
+		h *= del
+		if d < 0 {
+			isign = -isign
+		}
+		if math.Abs(del-1.0) < 1e-16 {
+			break
+		}
+	}
+	f := h
+
+	// CF2: p + i q = -1/(2x) + i + (i/x) [(1/2)²-ν²] / (2(x+i) + [(3/2)²-ν²] / (2(x+2i) + ...))
+	F := complex(tiny, 0)
+	C, D := F, complex(0, 0)
+	for k := 1; k < 10000; k++ {
+		odd := float64(2*k-1) / 2.0
+		ak := complex(odd*odd-ν*ν, 0)
+		bk := complex(2.0*x, 2.0*float64(k))
+		D = bk + ak*D
+		if cmplx.Abs(D) < tiny {
+			D = complex(tiny, 0)
+		}
+		D = 1.0 / D
+		C = bk + ak/C
+		if cmplx.Abs(C) < tiny {
+			C = complex(tiny, 0)
+		}
+		del := C * D
+		F *= del
+		if cmplx.Abs(del-1) < 1e-16 {
+			break
+		}
+	}
+	pq := complex(-0.5*xi, 1) + complex(0, xi)*F
+	p, q := real(pq), imag(pq)
+
+	// Jν and Yν from the Wronskian Jν Yν' - Jν' Yν = 2/(π x)
+	γ := (p - f) / q
+	j = math.Sqrt(2.0 * xi / math.Pi / ((p-f)*γ + q))
+	j = math.Copysign(j, isign)
+	y = j * γ
+	return
+}
+
+// hankelPQ computes the sums P and Q of Hankel's asymptotic expansion of Jν(x) and Yν(x)
+//
+//         ∞      k  a[2k]         ∞      k  a[2k+1]          (4ν² - 1²)(4ν² - 3²) ... (4ν² - (2k-1)²)
+//   P  =  Σ  (-1)   —————    Q =  Σ  (-1)   ———————   a[k] = ——————————————————————————————————————————
+//        k=0        x²ᵏ          k=0        x²ᵏ⁺¹                            k! 8ᵏ
+//
+func hankelPQ(ν, x float64) (P, Q float64) {
+	μ := 4.0 * ν * ν
+	term := 1.0
+	P = 1.0
+	for k := 1; k < 100; k++ {
+		odd := float64(2*k - 1)
+		next := term * (μ - odd*odd) / (float64(k) * 8.0 * x)
+		if math.Abs(next) >= math.Abs(term) { // the series is asymptotic: stop at the smallest term
+			break
+		}
+		term = next
+		switch k % 4 {
+		case 0:
+			P += term
+		case 1:
+			Q += term
+		case 2:
+			P -= term
+		case 3:
+			Q -= term
+		}
+		if math.Abs(term) < 1e-17*math.Abs(P) {
+			break
+		}
+	}
+	return
+}
@@ -0,0 +1,99 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// Akima implements the piecewise cubic interpolant of Akima [1]. The derivatives at the knots
+// are computed locally from the slopes of the two segments on each side; thus an outlier only
+// affects the neighbouring segments and the interpolant does not oscillate as the cubic spline
+//
+//   Reference:
+//   [1] Akima H (1970) A new method of interpolation and smooth curve fitting based on local
+//       procedures. Journal of the ACM, 17(4):589-602
+//
+type Akima struct {
+	X []float64 // knots (strictly increasing)
+	Y []float64 // values at knots
+	D []float64 // first derivatives at knots
+}
+
+// NewAkima creates a new Akima interpolant
+//
+//   Input:
+//     xs -- strictly increasing knots; len(xs) ≥ 5
+//     ys -- values at knots; len(ys) = len(xs)
+//
+//   NOTE: (1) with the slopes m[i] = (ys[i+1] - ys[i]) / (xs[i+1] - xs[i]), the derivative at the
+//             knot i is the weighted mean
+//
+//                    |m[i+1] - m[i]| m[i-1] + |m[i-1] - m[i-2]| m[i]
+//             D[i] = ———————————————————————————————————————————————
+//                         |m[i+1] - m[i]| + |m[i-1] - m[i-2]|
+//
+//             or (m[i-1] + m[i]) / 2 if both weights are zero
+//         (2) the two missing slopes at each end are extrapolated linearly as in [1]; e.g.
+//             m[-1] = 2 m[0] - m[1] and m[-2] = 3 m[0] - 2 m[1]
+//         (3) straight lines are reproduced exactly; the interpolant is C¹
+//         (4) xs and ys are copied
+//
+func NewAkima(xs, ys []float64) (o *Akima, err error) {
+
+	// check
+	n := len(xs)
+	if len(ys) != n {
+		return nil, chk.Err("lengths of data sets must be the same. %d != %d", n, len(ys))
+	}
+	if n < 5 {
+		return nil, chk.Err("at least 5 knots are required. len(xs) = %d is invalid", n)
+	}
+	for i := 1; i < n; i++ {
+		if xs[i] <= xs[i-1] {
+			return nil, chk.Err("knots must be strictly increasing. xs[%d] = %g ≤ xs[%d] = %g", i, xs[i], i-1, xs[i-1])
+		}
+	}
+
+	// new interpolant
+	o = new(Akima)
+	o.X = make([]float64, n)
+	o.Y = make([]float64, n)
+	o.D = make([]float64, n)
+	copy(o.X, xs)
+	copy(o.Y, ys)
+
+	// slopes of segments: m[i] is stored at s[i+2] for i = -2 ... n
+	s := make([]float64, n+3)
+	for i := 0; i < n-1; i++ {
+		s[i+2] = (ys[i+1] - ys[i]) / (xs[i+1] - xs[i])
+	}
+	s[1] = 2*s[2] - s[3]
+	s[0] = 3*s[2] - 2*s[3]
+	s[n+1] = 2*s[n] - s[n-1]
+	s[n+2] = 3*s[n] - 2*s[n-1]
+
+	// derivatives
+	for i := 0; i < n; i++ {
+		w1 := math.Abs(s[i+3] - s[i+2])
+		w2 := math.Abs(s[i+1] - s[i])
+		if w1+w2 == 0 {
+			o.D[i] = (s[i+1] + s[i+2]) / 2
+			continue
+		}
+		o.D[i] = (w1*s[i+1] + w2*s[i+2]) / (w1 + w2)
+	}
+	return
+}
+
+// Eval evaluates the interpolant at x
+//
+//   NOTE: outside [X[0], X[n-1]] the end polynomials are used for extrapolation
+//
+func (o *Akima) Eval(x float64) float64 {
+	return hermiteEval(o.X, o.Y, o.D, x)
+}
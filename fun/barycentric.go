@@ -0,0 +1,107 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// Barycentric implements the polynomial interpolant through arbitrary (distinct) nodes using the
+// second (true) barycentric formula [1]
+//
+//                N    w[j] ⋅ y[j]
+//                Σ    ———————————
+//               j=0    x - x[j]
+//       p(x) = ————————————————————
+//                N      w[j]
+//                Σ    ————————
+//               j=0   x - x[j]
+//
+//   with the barycentric weights:
+//
+//                       1
+//       w[j] = ———————————————————
+//               ┃┃  (x[j] - x[k])
+//              k≠j
+//
+//   Reference:
+//   [1] Berrut JP, Trefethen LN (2004) Barycentric Lagrange Interpolation,
+//       SIAM Review Vol. 46, No. 3, pp. 501-517
+//
+type Barycentric struct {
+	X []float64 // nodes
+	Y []float64 // values at nodes
+	W []float64 // barycentric weights (normalised such that max(|W|) = 1)
+}
+
+// NewBarycentric creates a new barycentric interpolant and computes the weights
+//
+//   Input:
+//     xs -- distinct nodes (in any order)
+//     ys -- values at nodes; len(ys) = len(xs)
+//
+//   NOTE: (1) the weights are computed once in O(n²) operations; each evaluation then costs O(n)
+//         (2) the weights are scaled by a common factor, which cancels out in the formula
+//         (3) xs and ys are copied
+//
+func NewBarycentric(xs, ys []float64) (o *Barycentric) {
+
+	// check
+	n := len(xs)
+	if len(ys) != n {
+		chk.Panic("lengths of data sets must be the same. %d != %d\n", n, len(ys))
+	}
+	if n < 1 {
+		chk.Panic("at least one node is required\n")
+	}
+
+	// new interpolant
+	o = new(Barycentric)
+	o.X = make([]float64, n)
+	o.Y = make([]float64, n)
+	o.W = make([]float64, n)
+	copy(o.X, xs)
+	copy(o.Y, ys)
+
+	// weights
+	wmax := 0.0
+	for j := 0; j < n; j++ {
+		prod := 1.0
+		for k := 0; k < n; k++ {
+			if k != j {
+				if xs[j] == xs[k] {
+					chk.Panic("nodes must be distinct. xs[%d] = xs[%d] = %g\n", j, k, xs[j])
+				}
+				prod *= xs[j] - xs[k]
+			}
+		}
+		o.W[j] = 1 / prod
+		wmax = math.Max(wmax, math.Abs(o.W[j]))
+	}
+	for j := 0; j < n; j++ {
+		o.W[j] /= wmax
+	}
+	return
+}
+
+// Eval evaluates the interpolant at x
+//
+//   NOTE: if x coincides with a node, the corresponding value is returned directly
+//
+func (o *Barycentric) Eval(x float64) float64 {
+	var num, den float64
+	for j, xj := range o.X {
+		dx := x - xj
+		if dx == 0 {
+			return o.Y[j]
+		}
+		t := o.W[j] / dx
+		num += t * o.Y[j]
+		den += t
+	}
+	return num / den
+}
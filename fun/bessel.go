@@ -0,0 +1,136 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import "math"
+
+// BesselJ returns the Bessel function of the first kind Jn(x) for integer n and any real x
+//
+//   NOTE: (1) J0 and J1 are computed with the rational approximations of math.J0 and math.J1
+//         (2) for |x| > n, the upward recurrence J[k+1] = (2k/x) J[k] - J[k-1] is used;
+//             otherwise, Miller's downward recurrence normalised by 1 = J0 + 2 Σ J[2k] is
+//             used because the upward recurrence is unstable
+//         (3) negative n and x are handled by J[-n](x) = (-1)ⁿ Jn(x) and Jn(-x) = (-1)ⁿ Jn(x)
+//
+func BesselJ(n int, x float64) float64 {
+
+	// symmetries
+	sign := 1.0
+	if n < 0 {
+		n = -n
+		if n&1 != 0 {
+			sign = -sign
+		}
+	}
+	if x < 0 {
+		x = -x
+		if n&1 != 0 {
+			sign = -sign
+		}
+	}
+
+	// simple cases
+	if n == 0 {
+		return sign * math.J0(x)
+	}
+	if n == 1 {
+		return sign * math.J1(x)
+	}
+	if x == 0 {
+		return 0
+	}
+
+	// upward recurrence
+	tox := 2.0 / x
+	if x > float64(n) {
+		bjm := math.J0(x)
+		bj := math.J1(x)
+		var bjp float64
+		for j := 1; j < n; j++ {
+			bjp = float64(j)*tox*bj - bjm
+			bjm = bj
+			bj = bjp
+		}
+		return sign * bj
+	}
+
+	// downward recurrence
+	ACC := 160.0 // ACC determines accuracy
+	IEXP := 1024 / 2
+	m := 2 * ((n + int(math.Sqrt(ACC*float64(n)))) / 2)
+	even := false
+	var ans, sum, bjm float64
+	bjp, bj := 0.0, 1.0
+	var k int
+	for j := m; j > 0; j-- {
+		bjm = float64(j)*tox*bj - bjp
+		bjp = bj
+		bj = bjm
+		_, k = math.Frexp(bj)
+		if k > IEXP { // renormalise to prevent overflows
+			bj = math.Ldexp(bj, -IEXP)
+			bjp = math.Ldexp(bjp, -IEXP)
+			ans = math.Ldexp(ans, -IEXP)
+			sum = math.Ldexp(sum, -IEXP)
+		}
+		if even {
+			sum += bj
+		}
+		even = !even
+		if j == n {
+			ans = bjp
+		}
+	}
+	sum = 2.0*sum - bj
+	return sign * ans / sum
+}
+
+// BesselY returns the Bessel function of the second kind Yn(x) for integer n and x ≥ 0
+//
+//   NOTE: (1) Y0 and Y1 are computed with the rational approximations of math.Y0 and math.Y1
+//         (2) the upward recurrence Y[k+1] = (2k/x) Y[k] - Y[k-1] is used (it is stable)
+//         (3) negative n is handled by Y[-n](x) = (-1)ⁿ Yn(x)
+//
+//   Special cases:
+//     Yn(x=0) = -Inf (or +Inf for negative odd n)
+//     Yn(x<0) = NaN
+//
+func BesselY(n int, x float64) float64 {
+
+	// symmetry
+	sign := 1.0
+	if n < 0 {
+		n = -n
+		if n&1 != 0 {
+			sign = -sign
+		}
+	}
+
+	// special cases
+	if x < 0 || math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x == 0 {
+		return math.Inf(-int(sign))
+	}
+	if n == 0 {
+		return sign * math.Y0(x)
+	}
+	if n == 1 {
+		return sign * math.Y1(x)
+	}
+
+	// upward recurrence
+	tox := 2.0 / x
+	bym := math.Y0(x)
+	by := math.Y1(x)
+	var byp float64
+	for j := 1; j < n; j++ {
+		byp = float64(j)*tox*by - bym
+		bym = by
+		by = byp
+	}
+	return sign * by
+}
@@ -0,0 +1,165 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// DftAny computes the discrete Fourier transform (DFT) of x with any length N (not necessarily
+// an integer power of 2) by means of Bluestein's algorithm
+//
+//   x       -- complex array of length N ≥ 1
+//   inverse -- computes the inverse transform (normalised by N) instead
+//
+//   X -- is a newly allocated array with the (inverse) DFT of x; x is not modified
+//
+//   NOTE: (1) if N is a power of 2, Dft is called directly
+//         (2) otherwise, using j⋅k = (j² + k² - (k-j)²)/2, the DFT is written as a convolution
+//             that is computed with FourierTransLL after zero-padding to a power of 2 M ≥ 2N-1
+//
+func DftAny(x []complex128, inverse bool) (X []complex128, err error) {
+
+	// check
+	N := len(x)
+	if N < 1 {
+		return nil, chk.Err("the length of x must be at least 1. N = %d is invalid", N)
+	}
+	if utl.IsPowerOfTwo(N) {
+		return Dft(x, inverse)
+	}
+
+	// chirp: w[j] = exp(∓i π j² / N)
+	sign := -1.0
+	if inverse {
+		sign = 1.0
+	}
+	w := make([]complex128, N)
+	for j := 0; j < N; j++ {
+		j2 := (j * j) % (2 * N) // avoid loss of precision for large j
+		w[j] = ExpPix(sign * math.Pi * float64(j2) / float64(N))
+	}
+
+	// zero-padded sequences
+	M := utl.NextPowerOfTwo(2*N - 1)
+	a := make([]complex128, M)
+	b := make([]complex128, M)
+	for j := 0; j < N; j++ {
+		a[j] = x[j] * w[j]
+		b[j] = cmplx.Conj(w[j])
+	}
+	for j := 1; j < N; j++ {
+		b[M-j] = b[j]
+	}
+
+	// convolution
+	err = DftInplace(a, false)
+	if err != nil {
+		return
+	}
+	err = DftInplace(b, false)
+	if err != nil {
+		return
+	}
+	for k := 0; k < M; k++ {
+		a[k] *= b[k]
+	}
+	err = DftInplace(a, true)
+	if err != nil {
+		return
+	}
+
+	// results
+	X = make([]complex128, N)
+	for k := 0; k < N; k++ {
+		X[k] = w[k] * a[k]
+		if inverse {
+			X[k] /= complex(float64(N), 0)
+		}
+	}
+	return
+}
+
+// ChirpZ computes the chirp z-transform of x; i.e. the z-transform evaluated at m points along a
+// spiral contour in the complex plane
+//
+//   x -- complex array of length N ≥ 1
+//   m -- number of output points; m ≥ 1
+//   w -- ratio between consecutive points of the contour
+//   a -- starting point of the contour; a ≠ 0
+//
+//   Computes:
+//                N-1         -j   j k                    -k
+//         X[k] =  Σ  x[j] ⋅ a  ⋅ w         i.e.  z[k] = a ⋅ w     for k = 0, 1, ..., m-1
+//                j=0
+//
+//   NOTE: (1) with a = 1, w = exp(-i 2 π / N) and m = N, the DFT of x is obtained
+//         (2) with a = exp(i 2 π f1 / fs) and w = exp(-i 2 π (f2 - f1) / (m fs)), the spectrum
+//             is computed at m frequencies in [f1, f2) ("zoom FFT")
+//         (3) the convolution of Bluestein's algorithm is computed with FourierTransLL after
+//             zero-padding to a power of 2 L ≥ N+m-1
+//
+func ChirpZ(x []complex128, m int, w, a complex128) (X []complex128, err error) {
+
+	// check
+	N := len(x)
+	if N < 1 {
+		return nil, chk.Err("the length of x must be at least 1. N = %d is invalid", N)
+	}
+	if m < 1 {
+		return nil, chk.Err("the number of output points must be at least 1. m = %d is invalid", m)
+	}
+	if w == 0 || a == 0 {
+		return nil, chk.Err("w and a must be non-zero. w = %v and a = %v are invalid", w, a)
+	}
+
+	// chirp: c[t] = w^(t²/2)
+	lw, la := cmplx.Log(w), cmplx.Log(a)
+	chirp := func(t int) complex128 {
+		return cmplx.Exp(complex(float64(t)*float64(t)/2.0, 0) * lw)
+	}
+
+	// zero-padded sequences
+	L := utl.NextPowerOfTwo(N + m - 1)
+	y := make([]complex128, L)
+	h := make([]complex128, L)
+	for j := 0; j < N; j++ {
+		y[j] = x[j] * cmplx.Exp(complex(-float64(j), 0)*la) * chirp(j)
+	}
+	for t := 0; t < m; t++ {
+		h[t] = 1.0 / chirp(t)
+	}
+	for t := 1; t < N; t++ {
+		h[L-t] = 1.0 / chirp(t)
+	}
+
+	// convolution
+	err = DftInplace(y, false)
+	if err != nil {
+		return
+	}
+	err = DftInplace(h, false)
+	if err != nil {
+		return
+	}
+	for k := 0; k < L; k++ {
+		y[k] *= h[k]
+	}
+	err = DftInplace(y, true)
+	if err != nil {
+		return
+	}
+
+	// results
+	X = make([]complex128, m)
+	for k := 0; k < m; k++ {
+		X[k] = chirp(k) * y[k]
+	}
+	return
+}
@@ -0,0 +1,83 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// RealCepstrum computes the real cepstrum of x
+//
+//   x -- real signal with N samples; N must be an integer power of 2
+//
+//   Computes:
+//     c = real( IDFT( log|DFT(x)| ) )
+//
+//   NOTE: (1) an echo with delay d in x, i.e. x[n] + a⋅x[n-d], causes peaks at the quefrencies
+//             n = d (and n = N-d) of the cepstrum
+//         (2) an error is returned if any bin of the spectrum is zero
+//
+func RealCepstrum(x []float64) (c []float64, err error) {
+	X, err := RealFourierTrans(x)
+	if err != nil {
+		return
+	}
+	for k := 0; k < len(X); k++ {
+		a := cmplx.Abs(X[k])
+		if a == 0 {
+			return nil, chk.Err("the logarithm of the spectrum cannot be computed because bin %d is zero", k)
+		}
+		X[k] = complex(math.Log(a), 0)
+	}
+	return RealFourierTransInv(X, len(x))
+}
+
+// ComplexCepstrum computes the complex cepstrum of x
+//
+//   x -- real signal with N samples; N must be an integer power of 2
+//
+//   Computes:
+//     c = real( IDFT( log|DFT(x)| + i φ ) )
+//
+//     where φ is the unwrapped phase of the spectrum with the linear phase term removed; i.e.
+//     φ[k] ← φ[k] - π r k / (N/2) with r = round(φ[N/2] / π)
+//
+//   NOTE: (1) an echo with delay d in x, i.e. x[n] + a⋅x[n-d] with |a| < 1, causes peaks with
+//             amplitudes a, -a²/2, a³/3, ... at the quefrencies n = d, 2d, 3d, ...
+//         (2) an error is returned if any bin of the spectrum is zero
+//         (3) the sign of x (the phase π of a negative DC bin) is not represented in c
+//
+func ComplexCepstrum(x []float64) (c []float64, err error) {
+
+	// spectrum
+	X, err := RealFourierTrans(x)
+	if err != nil {
+		return
+	}
+	for k := 0; k < len(X); k++ {
+		if X[k] == 0 {
+			return nil, chk.Err("the logarithm of the spectrum cannot be computed because bin %d is zero", k)
+		}
+	}
+
+	// unwrapped phase without linear term
+	φ := Unwrap(Phase(X))
+	M := len(X) - 1 // N/2
+	if M > 0 {
+		r := math.Round(φ[M] / math.Pi)
+		for k := 0; k <= M; k++ {
+			φ[k] -= math.Pi * r * float64(k) / float64(M)
+		}
+	}
+
+	// complex logarithm and inverse transform
+	for k := 0; k < len(X); k++ {
+		X[k] = complex(math.Log(cmplx.Abs(X[k])), φ[k])
+	}
+	return RealFourierTransInv(X, len(x))
+}
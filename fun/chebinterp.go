@@ -0,0 +1,154 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// ChebInterp implements the Chebyshev interpolant of a function on an arbitrary interval [a, b]
+//
+//                  N
+//         p(x) =   Σ  Coef[k] ⋅ T_k(ξ)      with ξ = (2x - a - b) / (b - a)  ∈ [-1, 1]
+//                 k=0
+//
+//   NOTE: in contrast to ChebyInterp, which works on [-1, 1] and provides differentiation
+//         matrices, ChebInterp only stores the coefficients and uses the Clenshaw recurrence
+//         for the evaluation
+//
+type ChebInterp struct {
+	A    float64   // lower limit of interval
+	B    float64   // upper limit of interval
+	Coef []float64 // coefficients of the Chebyshev series; len(Coef) = degree + 1
+}
+
+// NewChebInterp computes the Chebyshev interpolant of f on [a, b]
+//
+//   Input:
+//     f      -- function to be interpolated
+//     a, b   -- interval with a < b
+//     degree -- degree N ≥ 1 of the interpolating polynomial
+//
+//   The function is sampled at the N+1 Chebyshev-Gauss-Lobatto points:
+//
+//                     j⋅π
+//        ξ[j] = cos( ————— )      j = 0 ... N
+//                      N
+//
+//   and the coefficients are given by (a DCT-I of the samples):
+//
+//                  2       N   f[j]        j⋅k⋅π
+//        c[k] = ————————   Σ   ———— ⋅ cos( ————— )
+//               N ⋅ s[k]  j=0  s[j]          N
+//
+//   with s[0] = s[N] = 2 and s[j] = 1 otherwise
+//
+//   NOTE: if 2N is a power of 2, the coefficients are computed with RealFourierTrans applied to
+//         the even extension of the samples; otherwise, the sums are directly computed
+//
+func NewChebInterp(f Ss, a, b float64, degree int) (o *ChebInterp) {
+
+	// check
+	if degree < 1 {
+		chk.Panic("degree must be at least 1. degree = %d is invalid\n", degree)
+	}
+	if a >= b {
+		chk.Panic("the interval must satisfy a < b. [%g, %g] is invalid\n", a, b)
+	}
+
+	// sample function at Chebyshev-Gauss-Lobatto points
+	N := degree
+	fj := make([]float64, N+1)
+	for j := 0; j <= N; j++ {
+		ξ := math.Cos(float64(j) * math.Pi / float64(N))
+		fj[j] = f(0.5*(a+b) + 0.5*(b-a)*ξ)
+	}
+
+	// coefficients
+	o = &ChebInterp{A: a, B: b, Coef: make([]float64, N+1)}
+	if utl.IsPowerOfTwo(2 * N) {
+		v := make([]float64, 2*N)
+		copy(v, fj)
+		for j := 1; j < N; j++ {
+			v[2*N-j] = fj[j]
+		}
+		V, err := RealFourierTrans(v)
+		if err != nil {
+			chk.Panic("%v\n", err)
+		}
+		for k := 0; k <= N; k++ {
+			o.Coef[k] = real(V[k]) / float64(N)
+		}
+	} else {
+		for k := 0; k <= N; k++ {
+			sum := 0.5 * (fj[0] + math.Cos(float64(k)*math.Pi)*fj[N])
+			for j := 1; j < N; j++ {
+				sum += fj[j] * math.Cos(float64(j*k)*math.Pi/float64(N))
+			}
+			o.Coef[k] = 2 * sum / float64(N)
+		}
+	}
+	o.Coef[0] /= 2
+	o.Coef[N] /= 2
+	return
+}
+
+// Eval evaluates the interpolant at x using the Clenshaw recurrence
+//
+//   NOTE: x outside [a, b] extrapolates the polynomial
+//
+func (o *ChebInterp) Eval(x float64) float64 {
+	ξ := (2*x - o.A - o.B) / (o.B - o.A)
+	var b1, b2 float64
+	for k := len(o.Coef) - 1; k >= 1; k-- {
+		b1, b2 = o.Coef[k]+2*ξ*b1-b2, b1
+	}
+	return o.Coef[0] + ξ*b1 - b2
+}
+
+// Integral computes the integral of the interpolant over [a, b]
+//
+//        b             b - a           2
+//        ∫ p(x) dx  =  —————   Σ  c[k] ———————
+//        a               2   k even   1 - k²
+//
+func (o *ChebInterp) Integral() (res float64) {
+	for k := 0; k < len(o.Coef); k += 2 {
+		res += 2 * o.Coef[k] / float64(1-k*k)
+	}
+	return res * (o.B - o.A) / 2
+}
+
+// Derivative returns a new interpolant representing the derivative of o; i.e. dp/dx, obtained
+// from the coefficients of o by the recurrence
+//
+//        c'[k-1] = c'[k+1] + 2 k c[k]      k = N ... 1,   with c'[N] = c'[N+1] = 0
+//
+//   and then c'[0] is halved and all coefficients are multiplied by dξ/dx = 2/(b-a)
+//
+//   NOTE: the function is not sampled again; the degree of the result is N-1 (or 0 if N = 0)
+//
+func (o *ChebInterp) Derivative() (d *ChebInterp) {
+	N := len(o.Coef) - 1
+	d = &ChebInterp{A: o.A, B: o.B}
+	if N < 1 {
+		d.Coef = []float64{0}
+		return
+	}
+	d.Coef = make([]float64, N+2) // c'[N] = c'[N+1] = 0
+	for k := N; k >= 1; k-- {
+		d.Coef[k-1] = d.Coef[k+1] + 2*float64(k)*o.Coef[k]
+	}
+	d.Coef[0] /= 2
+	d.Coef = d.Coef[:N]
+	scale := 2 / (o.B - o.A)
+	for k := range d.Coef {
+		d.Coef[k] *= scale
+	}
+	return
+}
@@ -0,0 +1,420 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// Convolve computes the (linear) discrete convolution of a and b
+//
+//   Computes:
+//              __
+//     c[k] =   \   a[j] ⋅ b[k-j]     for all j where both indices are valid
+//              /_
+//              j
+//
+//   mode -- defines the length of the output (as in numpy.convolve); with M = len(a) and
+//           N = len(b):
+//             "full"  -- M+N-1 values; i.e. the whole convolution
+//             "same"  -- max(M,N) values centered with respect to the "full" output
+//             "valid" -- max(M,N)-min(M,N)+1 values where a and b overlap completely
+//
+//   NOTE: the inputs are zero-padded to the next power of 2 and the product of their spectra
+//         is computed with RealFourierTrans; for small inputs the direct sum is used instead
+//
+func Convolve(a, b []float64, mode string) (c []float64, err error) {
+
+	// check
+	M, N := len(a), len(b)
+	if M < 1 || N < 1 {
+		return nil, chk.Err("the lengths of a and b must be at least 1. len(a) = %d and len(b) = %d are invalid", M, N)
+	}
+
+	// output range
+	nmin, nmax := M, N
+	if M > N {
+		nmin, nmax = N, M
+	}
+	var start, length int
+	switch mode {
+	case "full":
+		start, length = 0, M+N-1
+	case "same":
+		start, length = (nmin-1)/2, nmax
+	case "valid":
+		start, length = nmin-1, nmax-nmin+1
+	default:
+		return nil, chk.Err("mode %q is invalid. mode must be \"full\", \"same\" or \"valid\"", mode)
+	}
+
+	// full convolution
+	var full []float64
+	if M*N <= convolveDirectMaxWork {
+		full = convolveDirect(a, b)
+	} else {
+		full, err = convolveFFT(a, b)
+		if err != nil {
+			return
+		}
+	}
+	return full[start : start+length], nil
+}
+
+// CircularConvolve computes the circular (cyclic) convolution of a and b
+//
+//   Computes:
+//             N-1
+//     c[k] =   Σ  a[j] ⋅ b[(k-j) mod N]
+//             j=0
+//
+//   NOTE: (1) a and b must have the same length N and N must be an integer power of 2
+//         (2) the result is computed by the pointwise product of the spectra of a and b
+//         (3) unlike Convolve, the signals are treated as periodic; so c has length N too
+//
+func CircularConvolve(a, b []float64) (c []float64, err error) {
+
+	// check
+	N := len(a)
+	if len(b) != N {
+		return nil, chk.Err("a and b must have the same length. len(a) = %d and len(b) = %d are invalid", N, len(b))
+	}
+	if !utl.IsPowerOfTwo(N) {
+		return nil, chk.Err("the length of a and b must be power of 2. N = %d is invalid", N)
+	}
+
+	// compute
+	A, err := RealFourierTrans(a)
+	if err != nil {
+		return
+	}
+	B, err := RealFourierTrans(b)
+	if err != nil {
+		return
+	}
+	for k := 0; k < len(A); k++ {
+		A[k] *= B[k]
+	}
+	return RealFourierTransInv(A, N)
+}
+
+// CrossCorrelate computes the (linear, non-normalised) discrete cross-correlation of a and b
+//
+//   Computes:
+//               __
+//     r[ℓ] =    \   a[j] ⋅ b[j+ℓ]     for all j where both indices are valid
+//               /_
+//               j
+//
+//   with lags ℓ = -(M-1), ..., -1, 0, 1, ..., N-1 where M = len(a) and N = len(b)
+//
+//   Output:
+//     c -- array of length M+N-1 with the correlation at lag ℓ stored at c[ℓ+M-1];
+//          thus, the zero lag is located at c[M-1]. If b is a copy of a delayed by d samples;
+//          i.e. b[j] = a[j-d], the correlation peaks at ℓ = d
+//
+//   NOTE: the sequences are zero-padded to the next power of 2 and the cross-spectrum
+//         conj(A)⋅B is computed with RealFourierTrans
+//
+func CrossCorrelate(a, b []float64) (c []float64, err error) {
+
+	// check
+	M, N := len(a), len(b)
+	if M < 1 || N < 1 {
+		return nil, chk.Err("the lengths of a and b must be at least 1. len(a) = %d and len(b) = %d are invalid", M, N)
+	}
+
+	// circular correlation of zero-padded sequences
+	n := utl.NextPowerOfTwo(M + N - 1)
+	A, err := RealFourierTrans(zeroPadded(a, n))
+	if err != nil {
+		return
+	}
+	B, err := RealFourierTrans(zeroPadded(b, n))
+	if err != nil {
+		return
+	}
+	for k := 0; k < len(A); k++ {
+		A[k] = cmplx.Conj(A[k]) * B[k]
+	}
+	r, err := RealFourierTransInv(A, n)
+	if err != nil {
+		return
+	}
+
+	// reorder lags; negative lags are wrapped at the end of r
+	c = make([]float64, M+N-1)
+	for k := 0; k < len(c); k++ {
+		ℓ := k - (M - 1)
+		if ℓ < 0 {
+			c[k] = r[n+ℓ]
+		} else {
+			c[k] = r[ℓ]
+		}
+	}
+	return
+}
+
+// AutoCorrelate computes the (linear, non-normalised) discrete autocorrelation of a
+//
+//   Output:
+//     c -- symmetric array of length 2M-1 with the autocorrelation at lag ℓ stored at c[ℓ+M-1]
+//          for ℓ = -(M-1), ..., M-1 where M = len(a); thus the zero lag is located at c[M-1]
+//
+//   NOTE: see CrossCorrelate
+//
+func AutoCorrelate(a []float64) (c []float64, err error) {
+	return CrossCorrelate(a, a)
+}
+
+// ToeplitzMatVec computes the product y = T ⋅ x of a Toeplitz matrix T and a vector x by means of
+// the FFT
+//
+//   firstCol -- first column of T (length m); T[i][0] = firstCol[i]
+//   firstRow -- first row of T (length n); T[0][j] = firstRow[j]; firstRow[0] must equal firstCol[0]
+//   x        -- vector of length n
+//
+//   Output:
+//     y -- vector of length m with y[i] = Σ T[i][j] x[j] where T[i][j] = t[i-j] is constant
+//          along the diagonals
+//
+//   NOTE: T is embedded in a circulant matrix of size L ≥ m+n-1 (L is a power of 2) whose
+//         first column is c = [firstCol, 0, ..., 0, firstRow[n-1], ..., firstRow[1]]. Then
+//         the product is computed with CircularConvolve in O(L log L) operations
+//
+func ToeplitzMatVec(firstCol, firstRow, x []float64) (y []float64, err error) {
+
+	// check
+	m, n := len(firstCol), len(firstRow)
+	if m < 1 || n < 1 {
+		return nil, chk.Err("the first column and first row must have at least one element. len(firstCol) = %d and len(firstRow) = %d are invalid", m, n)
+	}
+	if len(x) != n {
+		return nil, chk.Err("the length of x must be equal to len(firstRow) = %d. len(x) = %d is invalid", n, len(x))
+	}
+	if firstCol[0] != firstRow[0] {
+		return nil, chk.Err("firstCol[0] and firstRow[0] must be equal. %g != %g", firstCol[0], firstRow[0])
+	}
+
+	// circulant embedding
+	L := utl.NextPowerOfTwo(m + n - 1)
+	c := make([]float64, L)
+	copy(c, firstCol)
+	for j := 1; j < n; j++ {
+		c[L-j] = firstRow[j]
+	}
+
+	// product
+	z, err := CircularConvolve(c, zeroPadded(x, L))
+	if err != nil {
+		return
+	}
+	return z[:m], nil
+}
+
+// PolyMul multiplies two polynomials by means of the FFT
+//
+//   a, b -- coefficients in ascending degree; e.g. a = [a0, a1, a2] ⇒ a(x) = a0 + a1 x + a2 x²
+//
+//   Output:
+//     c -- len(a)+len(b)-1 coefficients of c(x) = a(x) ⋅ b(x) in ascending degree;
+//          nil if a or b is empty
+//
+//   NOTE: (1) the coefficients are zero-padded to the next power of 2 and the product of their
+//             spectra is computed with RealFourierTrans; for small inputs the schoolbook method
+//             is used instead
+//         (2) if all coefficients of a and b are integers, the result is rounded to the nearest
+//             integers, which is exact as long as the coefficients of c are smaller than 2⁵³
+//
+func PolyMul(a, b []float64) (c []float64) {
+	if len(a) < 1 || len(b) < 1 {
+		return nil
+	}
+	if len(a)*len(b) <= convolveDirectMaxWork {
+		return convolveDirect(a, b)
+	}
+	c, err := convolveFFT(a, b)
+	if err != nil {
+		chk.Panic("%v", err)
+	}
+	if allIntegers(a) && allIntegers(b) {
+		for i := 0; i < len(c); i++ {
+			c[i] = math.Round(c[i])
+		}
+	}
+	return
+}
+
+// OverlapAdd computes the full linear convolution of a long signal x with a filter h by means of
+// the overlap-add method
+//
+//   x        -- signal
+//   h        -- filter (impulse response)
+//   blockLen -- size of the FFTs; must be an integer power of 2 and greater than len(h)
+//
+//   Output:
+//     y -- len(x)+len(h)-1 values equal to Convolve(x, h, "full")
+//
+//   NOTE: x is split into blocks of S = blockLen-len(h)+1 samples; each block is convolved with
+//         h using FFTs of size blockLen and the overlapping tails of len(h)-1 samples are
+//         summed. Thus, the working arrays have length blockLen instead of the next power of 2
+//         of len(x)+len(h)-1
+//
+func OverlapAdd(x, h []float64, blockLen int) (y []float64, err error) {
+
+	// check
+	H, S, err := overlapSetup(x, h, blockLen)
+	if err != nil {
+		return
+	}
+
+	// blocks
+	M, N := len(x), len(h)
+	y = make([]float64, M+N-1)
+	for start := 0; start < M; start += S {
+		end := utl.Imin(start+S, M)
+		X, err := RealFourierTrans(zeroPadded(x[start:end], blockLen))
+		if err != nil {
+			return nil, err
+		}
+		for k := 0; k < len(X); k++ {
+			X[k] *= H[k]
+		}
+		z, err := RealFourierTransInv(X, blockLen)
+		if err != nil {
+			return nil, err
+		}
+		n := utl.Imin(blockLen, len(y)-start)
+		for i := 0; i < n; i++ {
+			y[start+i] += z[i]
+		}
+	}
+	return
+}
+
+// OverlapSave computes the full linear convolution of a long signal x with a filter h by means
+// of the overlap-save method
+//
+//   x        -- signal
+//   h        -- filter (impulse response)
+//   blockLen -- size of the FFTs; must be an integer power of 2 and greater than len(h)
+//
+//   Output:
+//     y -- len(x)+len(h)-1 values equal to Convolve(x, h, "full")
+//
+//   NOTE: the (zero-extended) signal is read in overlapping segments of blockLen samples, which
+//         advance by S = blockLen-len(h)+1 samples. The circular convolution of each segment
+//         with h is computed with FFTs of size blockLen and the first len(h)-1 (wrapped)
+//         samples are discarded. Unlike OverlapAdd, no tails need to be summed; thus this
+//         method is convenient for streaming
+//
+func OverlapSave(x, h []float64, blockLen int) (y []float64, err error) {
+
+	// check
+	H, S, err := overlapSetup(x, h, blockLen)
+	if err != nil {
+		return
+	}
+
+	// blocks; the segment starting at output index s holds x[s-N+1 : s-N+1+blockLen]
+	M, N := len(x), len(h)
+	y = make([]float64, M+N-1)
+	seg := make([]float64, blockLen)
+	for s := 0; s < len(y); s += S {
+		for i := 0; i < blockLen; i++ {
+			j := s - N + 1 + i
+			seg[i] = 0
+			if j >= 0 && j < M {
+				seg[i] = x[j]
+			}
+		}
+		X, err := RealFourierTrans(seg)
+		if err != nil {
+			return nil, err
+		}
+		for k := 0; k < len(X); k++ {
+			X[k] *= H[k]
+		}
+		z, err := RealFourierTransInv(X, blockLen)
+		if err != nil {
+			return nil, err
+		}
+		copy(y[s:utl.Imin(s+S, len(y))], z[N-1:])
+	}
+	return
+}
+
+// convolveDirectMaxWork is the maximum M⋅N for which the direct sum is used in Convolve
+const convolveDirectMaxWork = 1024
+
+// convolveDirect computes the full linear convolution by means of the direct sum
+func convolveDirect(a, b []float64) (c []float64) {
+	c = make([]float64, len(a)+len(b)-1)
+	for i := 0; i < len(a); i++ {
+		for j := 0; j < len(b); j++ {
+			c[i+j] += a[i] * b[j]
+		}
+	}
+	return
+}
+
+// convolveFFT computes the full linear convolution by means of RealFourierTrans
+func convolveFFT(a, b []float64) (c []float64, err error) {
+	L := len(a) + len(b) - 1
+	n := utl.NextPowerOfTwo(L)
+	A, err := RealFourierTrans(zeroPadded(a, n))
+	if err != nil {
+		return
+	}
+	B, err := RealFourierTrans(zeroPadded(b, n))
+	if err != nil {
+		return
+	}
+	for k := 0; k < len(A); k++ {
+		A[k] *= B[k]
+	}
+	c, err = RealFourierTransInv(A, n)
+	if err != nil {
+		return
+	}
+	return c[:L], nil
+}
+
+// zeroPadded returns a new array of length n ≥ len(x) with x copied into the front
+func zeroPadded(x []float64, n int) (y []float64) {
+	y = make([]float64, n)
+	copy(y, x)
+	return
+}
+
+// allIntegers returns true if all values in x are integers
+func allIntegers(x []float64) bool {
+	for _, v := range x {
+		if v != math.Trunc(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// overlapSetup checks the arguments of OverlapAdd and OverlapSave and returns the half spectrum of the
+// zero-padded filter and the number S of new samples per block
+func overlapSetup(x, h []float64, blockLen int) (H []complex128, S int, err error) {
+	if len(x) < 1 || len(h) < 1 {
+		return nil, 0, chk.Err("the lengths of x and h must be at least 1. len(x) = %d and len(h) = %d are invalid", len(x), len(h))
+	}
+	if !utl.IsPowerOfTwo(blockLen) {
+		return nil, 0, chk.Err("the block length must be power of 2. blockLen = %d is invalid", blockLen)
+	}
+	if blockLen <= len(h) {
+		return nil, 0, chk.Err("the block length must be greater than len(h) = %d. blockLen = %d is invalid", len(h), blockLen)
+	}
+	H, err = RealFourierTrans(zeroPadded(h, blockLen))
+	return H, blockLen - len(h) + 1, err
+}
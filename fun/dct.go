@@ -0,0 +1,169 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// Dct computes the orthonormal discrete cosine transform of type II (DCT-II)
+//
+//   Computes:
+//                     N-1           π k (2 j + 1)             | √(1/N)  if k = 0
+//     X[k] = s[k] ⋅  Σ  x[j] ⋅ cos( ————————————— )   s[k] = |
+//                     j=0                2 N                  | √(2/N)  otherwise
+//
+//   NOTE: (1) with this (orthonormal) scaling, the transform matrix is orthogonal; thus Idct
+//             is the exact inverse and the sum of squares of x is preserved
+//         (2) N = len(x) must be an integer power of 2 (panic otherwise)
+//         (3) the even/odd samples of x are reordered as in the even extension of x; thus a
+//             single complex FFT of size N is computed [1]
+//
+//   Reference:
+//   [1] Makhoul J (1980) A fast cosine transform in one and two dimensions. IEEE Transactions on
+//       Acoustics, Speech, and Signal Processing, 28(1):27-34
+//
+func Dct(x []float64) (X []float64) {
+
+	// check
+	N := len(x)
+	if !utl.IsPowerOfTwo(N) {
+		chk.Panic("the length of x must be power of 2. N = %d is invalid", N)
+	}
+
+	// reorder: v = [x0, x2, x4, ..., x5, x3, x1]
+	v := make([]complex128, N)
+	for j := 0; j < (N+1)/2; j++ {
+		v[j] = complex(x[2*j], 0)
+	}
+	for j := 0; j < N/2; j++ {
+		v[N-1-j] = complex(x[2*j+1], 0)
+	}
+	err := DftInplace(v, false)
+	if err != nil {
+		chk.Panic("%v", err)
+	}
+
+	// results
+	X = make([]float64, N)
+	s0, s := math.Sqrt(1.0/float64(N)), math.Sqrt(2.0/float64(N))
+	for k := 0; k < N; k++ {
+		X[k] = s * real(v[k]*ExpMix(math.Pi*float64(k)/float64(2*N)))
+	}
+	X[0] *= s0 / s
+	return
+}
+
+// Idct computes the orthonormal discrete cosine transform of type III (DCT-III); i.e. the
+// inverse of the orthonormal DCT-II computed by Dct
+//
+//   Computes:
+//              N-1                  π k (2 j + 1)
+//     x[j] =   Σ  s[k] ⋅ X[k] ⋅ cos( ————————————— )     with s[k] as in Dct
+//              k=0                       2 N
+//
+//   NOTE: N = len(X) must be an integer power of 2 (panic otherwise)
+//
+func Idct(X []float64) (x []float64) {
+
+	// check
+	N := len(X)
+	if !utl.IsPowerOfTwo(N) {
+		chk.Panic("the length of X must be power of 2. N = %d is invalid", N)
+	}
+
+	// recover the FFT of the reordered sequence: V[k] = exp(iπk/2N) ⋅ (C[k] - i C[N-k])
+	// where C are the unscaled DCT-II coefficients
+	s0, s := math.Sqrt(1.0/float64(N)), math.Sqrt(2.0/float64(N))
+	C := func(k int) float64 {
+		if k == 0 {
+			return X[0] / s0
+		}
+		if k == N {
+			return 0
+		}
+		return X[k] / s
+	}
+	v := make([]complex128, N)
+	for k := 0; k < N; k++ {
+		v[k] = ExpPix(math.Pi*float64(k)/float64(2*N)) * complex(C(k), -C(N-k))
+	}
+	err := DftInplace(v, true)
+	if err != nil {
+		chk.Panic("%v", err)
+	}
+
+	// undo reordering
+	x = make([]float64, N)
+	for j := 0; j < (N+1)/2; j++ {
+		x[2*j] = real(v[j])
+	}
+	for j := 0; j < N/2; j++ {
+		x[2*j+1] = real(v[N-1-j])
+	}
+	return
+}
+
+// Dst computes the discrete sine transform of type I (DST-I)
+//
+//   Computes:
+//             n-1             π (j + 1) (k + 1)
+//     X[k] =   Σ  x[j] ⋅ sin( ————————————————— )     with N = n + 1
+//             j=0                     N
+//
+//   NOTE: (1) the data x corresponds to the n = N-1 interior points of a grid with N intervals
+//             where the values at both boundaries are zero (Dirichlet conditions); e.g. u(x0)
+//             and u(xN)
+//         (2) N = len(x) + 1 must be an integer power of 2 (panic otherwise)
+//         (3) the transform is computed with a real FFT of size 2N on the odd extension of x
+//         (4) this transform is not normalised; see Idst
+//
+func Dst(x []float64) (X []float64) {
+
+	// check
+	N := len(x) + 1
+	if len(x) < 1 || !utl.IsPowerOfTwo(N) {
+		chk.Panic("the length of x plus one must be power of 2. len(x)+1 = %d is invalid", N)
+	}
+
+	// odd extension: y = [0, x0, x1, ..., x(n-1), 0, -x(n-1), ..., -x1, -x0]
+	y := make([]float64, 2*N)
+	for j := 0; j < N-1; j++ {
+		y[j+1] = x[j]
+		y[2*N-1-j] = -x[j]
+	}
+	Y, err := RealFourierTrans(y)
+	if err != nil {
+		chk.Panic("%v", err)
+	}
+
+	// results: Y[k] = -2 i X[k-1]
+	X = make([]float64, N-1)
+	for k := 0; k < N-1; k++ {
+		X[k] = -imag(Y[k+1]) / 2.0
+	}
+	return
+}
+
+// Idst computes the inverse of the discrete sine transform of type I (DST-I)
+//
+//   Computes:
+//              2  n-1             π (j + 1) (k + 1)
+//     x[j] =   —   Σ  X[k] ⋅ sin( ————————————————— )     with N = n + 1
+//              N  k=0                     N
+//
+//   NOTE: N = len(X) + 1 must be an integer power of 2 (panic otherwise)
+//
+func Idst(X []float64) (x []float64) {
+	x = Dst(X)
+	N := float64(len(X) + 1)
+	for j := 0; j < len(x); j++ {
+		x[j] *= 2.0 / N
+	}
+	return
+}
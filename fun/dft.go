@@ -7,6 +7,7 @@ package fun
 import (
 	"math"
 
+	"github.com/cpmech/gosl/chk"
 	"github.com/cpmech/gosl/fun/fftw"
 )
 
@@ -48,3 +49,39 @@ func dft1dslow(x []complex128) (X []complex128) {
 	}
 	return
 }
+
+// DftMatrix returns the n×n matrix of the discrete Fourier transform; i.e. X = W ⋅ x computes the
+// same result as Dft(x, inverse) but with n² operations
+//
+//                   -i 2 π k l / n
+//     forward:  W  = e                     k, l = 0 ... n-1
+//                kl
+//
+//                   1   +i 2 π k l / n
+//     inverse:  W  = — ⋅ e
+//                kl  n
+//
+//   NOTE: (1) the inverse matrix is normalised by 1/n, as in Dft; thus the product of the forward
+//             and inverse matrices is the identity matrix
+//         (2) the exponent k⋅l is reduced modulo n before computing the angle; thus the angles
+//             are in [0, 2π) and the rounding errors do not grow with k⋅l
+//         (3) any n ≥ 1 is accepted (not only powers of 2)
+//
+func DftMatrix(n int, inverse bool) (W [][]complex128) {
+	if n < 1 {
+		chk.Panic("the size of the DFT matrix must be at least 1. n = %d is invalid\n", n)
+	}
+	W = make([][]complex128, n)
+	for k := 0; k < n; k++ {
+		W[k] = make([]complex128, n)
+		for l := 0; l < n; l++ {
+			a := 2.0 * math.Pi * float64((k*l)%n) / float64(n)
+			if inverse {
+				W[k][l] = ExpPix(a) / complex(float64(n), 0)
+			} else {
+				W[k][l] = ExpMix(a)
+			}
+		}
+	}
+	return
+}
@@ -130,6 +130,74 @@ func Elliptic3(n, φ, k float64) float64 {
 	return s * (CarlsonRf(cc, q, 1.0) - t*CarlsonRj(cc, q, 1.0, 1.0+t)/3.0)
 }
 
+// EllipticK computes the complete elliptic integral of the first kind K(m) with parameter m = k²
+// by means of the arithmetic-geometric mean (AGM)
+//
+//   Computes:
+//                    π/2
+//                   ⌠          dt                    π
+//         K(m)  =   │  _________________  =  ————————————————————
+//                   │    _____________        2 AGM(1, √(1 - m))
+//                   ⌡  \╱ 1 - m sin²(t)
+//                  0
+//
+//   NOTE: (1) 0 ≤ m ≤ 1; the result is +Inf for m = 1 and NaN for m outside [0, 1]
+//         (2) the AGM converges quadratically; e.g. less than 10 iterations for m ≤ 1 - 1e-15
+//
+func EllipticK(m float64) float64 {
+	if m < 0 || m > 1 || math.IsNaN(m) {
+		return math.NaN()
+	}
+	if m == 1 {
+		return math.Inf(+1)
+	}
+	a, _, _ := ellipticAgm(m)
+	return math.Pi / (2 * a)
+}
+
+// EllipticE computes the complete elliptic integral of the second kind E(m) with parameter m = k²
+// by means of the arithmetic-geometric mean (AGM)
+//
+//   Computes:
+//                    π/2
+//                   ⌠    _____________                   ∞
+//         E(m)  =   │  \╱ 1 - m sin²(t)  dt  =  K(m) (1 - Σ 2ⁿ⁻¹ c[n]²)
+//                   ⌡                                   n=0
+//                  0
+//
+//   where c[0] = √m and c[n+1] = (a[n] - b[n]) / 2 are computed during the AGM iterations
+//
+//   NOTE: 0 ≤ m ≤ 1; the result is 1 for m = 1 and NaN for m outside [0, 1]
+//
+func EllipticE(m float64) float64 {
+	if m < 0 || m > 1 || math.IsNaN(m) {
+		return math.NaN()
+	}
+	if m == 1 {
+		return 1
+	}
+	a, sum, _ := ellipticAgm(m)
+	return math.Pi / (2 * a) * (1 - sum)
+}
+
+// ellipticAgm computes the arithmetic-geometric mean AGM(1, √(1-m)) and the sum Σ 2ⁿ⁻¹ c[n]²
+// for 0 ≤ m < 1. It also returns the number of iterations
+func ellipticAgm(m float64) (agm, sum float64, nit int) {
+	a, b := 1.0, math.Sqrt(1-m)
+	sum = 0.5 * m // n = 0: 2⁻¹ c[0]²
+	pow := 0.5
+	for nit = 0; nit < 100; nit++ {
+		c := 0.5 * (a - b)
+		pow *= 2
+		sum += pow * c * c
+		a, b = 0.5*(a+b), math.Sqrt(a*b)
+		if math.Abs(a-b) <= 1e-15*a {
+			break
+		}
+	}
+	return a, sum, nit + 1
+}
+
 // CarlsonRf computes Carlson's elliptic integral of the first kind according to [1]. See also [2]
 // Computes Rf(x,y,z) where x,y,z must be non-negative and at most one can be zero.
 //   References:
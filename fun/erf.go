@@ -0,0 +1,242 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import "math"
+
+// Erf computes the error function
+//
+//                 2    x    -t²
+//     erf(x) =  ———    ∫   e    dt
+//                √π    0
+//
+//   NOTE: (1) for |x| < 1.5, the series erf(x) = (2/√π) e^(-x²) Σ 2ⁿ x^(2n+1) / (1⋅3⋅…⋅(2n+1))
+//             is used (all terms are positive)
+//         (2) otherwise, erf(x) = 1 - erfc(x) with the continued fraction of Erfc
+//
+func Erf(x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case x < 0:
+		return -Erf(-x)
+	case x < erfSwitch:
+		return erfSeries(x)
+	}
+	return 1 - erfcFrac(x)
+}
+
+// Erfc computes the complementary error function erfc(x) = 1 - erf(x)
+//
+//   NOTE: (1) for x ≥ 1.5, the continued fraction
+//                        -x²
+//                       e           1     1/2     1     3/2     2
+//             erfc(x) = ———— ⋅ ————— ————— ————— ————— ————— ...
+//                        √π     x +   x +   x +   x +   x +
+//             is evaluated by the modified Lentz method; thus no cancellation occurs
+//         (2) for large x, the result underflows gracefully to 0 (e.g. for x > 27.3)
+//         (3) for x < 0, erfc(x) = 2 - erfc(-x)
+//
+func Erfc(x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case x < 0:
+		return 2 - Erfc(-x)
+	case x < erfSwitch:
+		return 1 - erfSeries(x)
+	}
+	return erfcFrac(x)
+}
+
+// ErfInv computes the inverse error function; i.e. x = ErfInv(p) such that Erf(x) = p
+//
+//   NOTE: (1) the initial approximation by Giles [1] is refined by Halley iterations
+//         (2) for |p| > 0.5, the iterations use Erfc to avoid cancellation near ±1
+//
+//   Special cases:
+//     ErfInv(±1) = ±Inf
+//     ErfInv(p)  = NaN for |p| > 1
+//
+//   Reference:
+//   [1] Giles M (2010) Approximating the erfinv function. GPU Computing Gems, Volume 2
+//
+func ErfInv(p float64) (x float64) {
+	switch {
+	case math.IsNaN(p) || p < -1 || p > 1:
+		return math.NaN()
+	case p == 1:
+		return math.Inf(+1)
+	case p == -1:
+		return math.Inf(-1)
+	case p == 0:
+		return 0
+	}
+
+	// initial approximation
+	w := -math.Log((1.0 - p) * (1.0 + p))
+	var a float64
+	if w < 5.0 {
+		w -= 2.5
+		a = 2.81022636e-08
+		a = 3.43273939e-07 + a*w
+		a = -3.5233877e-06 + a*w
+		a = -4.39150654e-06 + a*w
+		a = 0.00021858087 + a*w
+		a = -0.00125372503 + a*w
+		a = -0.00417768164 + a*w
+		a = 0.246640727 + a*w
+		a = 1.50140941 + a*w
+	} else {
+		w = math.Sqrt(w) - 3.0
+		a = -0.000200214257
+		a = 0.000100950558 + a*w
+		a = 0.00134934322 + a*w
+		a = -0.00367342844 + a*w
+		a = 0.00573950773 + a*w
+		a = -0.0076224613 + a*w
+		a = 0.00943887047 + a*w
+		a = 1.00167406 + a*w
+		a = 2.83297682 + a*w
+	}
+	x = a * p
+
+	// Halley iterations on f(x) = erf(x) - p  or  f(x) = erfc(|x|) - (1 - |p|)
+	ap, ax := math.Abs(p), math.Abs(x)
+	for it := 0; it < 20; it++ {
+		var f float64
+		if ap > 0.5 {
+			f = (1 - ap) - Erfc(ax) // equals erf(ax) - ap without cancellation
+		} else {
+			f = Erf(ax) - ap
+		}
+		df := 2.0 / math.SqrtPi * math.Exp(-ax*ax)
+		if df == 0 {
+			break
+		}
+		// Halley: Δ = f / (f' - f f''/(2f'))  with  f'' = -2 x f'
+		Δ := f / (df + ax*f)
+		ax -= Δ
+		if math.Abs(Δ) <= 1e-16*ax {
+			break
+		}
+	}
+	return math.Copysign(ax, p)
+}
+
+// erfSwitch is the argument above which the continued fraction is used for erf and erfc
+const erfSwitch = 1.5
+
+// erfSeries computes erf(x) for small x ≥ 0 by means of a series with positive terms
+func erfSeries(x float64) float64 {
+	x2 := x * x
+	term := x
+	sum := x
+	for n := 1; n < 200; n++ {
+		term *= 2 * x2 / float64(2*n+1)
+		sum += term
+		if term < 1e-17*sum {
+			break
+		}
+	}
+	return 2.0 / math.SqrtPi * math.Exp(-x2) * sum
+}
+
+// erfcFrac computes erfc(x) for x ≥ erfSwitch by means of the continued fraction (modified
+// Lentz method)
+func erfcFrac(x float64) float64 {
+	if x > 27.3 {
+		return 0 // underflow
+	}
+	const tiny = 1e-300
+	f := x
+	C := x
+	D := 0.0
+	for n := 1; n < 5000; n++ {
+		a := float64(n) / 2
+		D = x + a*D
+		if D == 0 {
+			D = tiny
+		}
+		C = x + a/C
+		if C == 0 {
+			C = tiny
+		}
+		D = 1 / D
+		Δ := C * D
+		f *= Δ
+		if math.Abs(Δ-1) < 1e-16 {
+			break
+		}
+	}
+	return math.Exp(-x*x) / (math.SqrtPi * f)
+}
+
+// Dawson computes Dawson's integral
+//
+//                    -x²   x    t²
+//     D(x)  =  e     ∫   e   dt       ⇒   D(x) = (√π/2) e^(-x²) erfi(x)
+//                    0
+//
+//   NOTE: (1) for |x| < 6.5, the series D(x) = e^(-x²) Σ x^(2n+1) / (n! (2n+1)) is used (all
+//             terms are positive); x² is split into exact high and low parts to keep the
+//             relative accuracy of the factor e^(-x²)
+//         (2) otherwise, the asymptotic expansion D(x) = (1/(2x)) Σ (2n-1)!! / (2x²)ⁿ is used
+//             and truncated at the smallest term; hence e^(x²) is never computed and there
+//             is no overflow
+//         (3) D is odd, D(±∞) = 0 and the maximum D = 0.5410442246... occurs at x = 0.9241388730...
+//
+func Dawson(x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case x < 0:
+		return -Dawson(-x)
+	case math.IsInf(x, 0):
+		return 0
+	case x < dawsonSwitch:
+		return dawsonSeries(x)
+	}
+	return dawsonAsymp(x)
+}
+
+// dawsonSwitch is the argument above which the asymptotic expansion is used for Dawson
+const dawsonSwitch = 6.5
+
+// dawsonSeries computes D(x) for 0 ≤ x < dawsonSwitch by means of a series with positive terms
+func dawsonSeries(x float64) float64 {
+	x2 := x * x
+	lo := math.FMA(x, x, -x2) // x² = x2 + lo exactly
+	term := 1.0               // x²ⁿ / n!
+	sum := 1.0
+	for n := 1; n < 500; n++ {
+		term *= x2 / float64(n)
+		t := term / float64(2*n+1)
+		sum += t
+		if t < 1e-17*sum {
+			break
+		}
+	}
+	return x * sum * math.Exp(-x2) * (1 - lo)
+}
+
+// dawsonAsymp computes D(x) for x ≥ dawsonSwitch by means of the asymptotic expansion
+func dawsonAsymp(x float64) float64 {
+	y := 0.5 / (x * x)
+	term := 1.0
+	sum := 1.0
+	for n := 1; n < 500; n++ {
+		next := term * float64(2*n-1) * y
+		if next >= term { // the series is asymptotic: stop at the smallest term
+			break
+		}
+		term = next
+		sum += term
+		if term < 1e-17*sum {
+			break
+		}
+	}
+	return sum / (2 * x)
+}
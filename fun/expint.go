@@ -0,0 +1,249 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import "math"
+
+// EulerGamma is the Euler-Mascheroni constant γ
+const EulerGamma = 0.57721566490153286060651209008240243104215933593992
+
+// Ei computes the exponential integral
+//
+//                   x   t
+//                   ⌠  e
+//     Ei(x) = P.V.  │  —— dt
+//                   ⌡  t
+//                  -∞
+//
+//   NOTE: (1) for 0 < x ≤ 40, the series Ei(x) = γ + ln(x) + Σ xᵏ/(k⋅k!) is used (all terms
+//             are positive)
+//         (2) for x > 40, the asymptotic expansion Ei(x) ≈ (eˣ/x) Σ k!/xᵏ is used
+//         (3) for x < 0, Ei(x) = -E1(-x)
+//
+//   Special cases:
+//     Ei(0)    = -Inf
+//     Ei(+Inf) = +Inf
+//
+func Ei(x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case x == 0:
+		return math.Inf(-1)
+	case x < 0:
+		return -E1(-x)
+	case x > 709.8:
+		return math.Inf(+1) // overflow
+	}
+	const eps = 1e-17
+	if x <= 40 {
+		sum, fact := 0.0, 1.0
+		for k := 1; k < 200; k++ {
+			fact *= x / float64(k)
+			term := fact / float64(k)
+			sum += term
+			if term < eps*sum {
+				break
+			}
+		}
+		return sum + math.Log(x) + EulerGamma
+	}
+	sum, term := 1.0, 1.0
+	for k := 1; k < 40; k++ {
+		prev := term
+		term *= float64(k) / x
+		if term < eps {
+			break
+		}
+		if term > prev { // the asymptotic series started to diverge
+			sum -= prev
+			break
+		}
+		sum += term
+	}
+	return math.Exp(x) * sum / x
+}
+
+// E1 computes the exponential integral
+//
+//               ∞  -t
+//               ⌠ e
+//     E1(x) =   │ ——— dt     x > 0
+//               ⌡  t
+//               x
+//
+//   NOTE: (1) for x ≤ 1, the series E1(x) = -γ - ln(x) - Σ (-x)ᵏ/(k⋅k!) is used
+//         (2) for x > 1, the continued fraction
+//                       -x
+//                      e    1   1²   2²
+//             E1(x) =  —— ————— ————— ————— ...
+//                          x+1-  x+3-  x+5-
+//             is evaluated by the modified Lentz method
+//
+//   Special cases:
+//     E1(0)    = +Inf
+//     E1(+Inf) = 0
+//     E1(x)    = NaN for x < 0
+//
+func E1(x float64) float64 {
+	switch {
+	case math.IsNaN(x) || x < 0:
+		return math.NaN()
+	case x == 0:
+		return math.Inf(+1)
+	case x > 745:
+		return 0 // underflow
+	}
+	const eps = 1e-17
+	if x <= 1 {
+		sum, fact := 0.0, 1.0
+		for k := 1; k < 100; k++ {
+			fact *= -x / float64(k)
+			term := fact / float64(k)
+			sum += term
+			if math.Abs(term) < eps*math.Abs(sum) {
+				break
+			}
+		}
+		return -EulerGamma - math.Log(x) - sum
+	}
+	const tiny = 1e-300
+	b := x + 1
+	C := 1 / tiny
+	D := 1 / b
+	h := D
+	for i := 1; i < 1000; i++ {
+		a := -float64(i * i)
+		b += 2
+		D = 1 / (a*D + b)
+		C = b + a/C
+		Δ := C * D
+		h *= Δ
+		if math.Abs(Δ-1) < eps {
+			break
+		}
+	}
+	return h * math.Exp(-x)
+}
+
+// Si computes the sine integral
+//
+//               x
+//               ⌠ sin(t)
+//     Si(x) =   │ —————— dt
+//               ⌡   t
+//               0
+//
+//   NOTE: (1) for |x| ≤ 2, the series Si(x) = Σ (-1)ᵏ x²ᵏ⁺¹ / ((2k+1)⋅(2k+1)!) is used
+//         (2) for |x| > 2, Si and Ci are obtained from the continued fraction of E1(i⋅x)
+//         (3) Si(-x) = -Si(x)
+//
+//   Special cases:
+//     Si(±Inf) = ±π/2
+//
+func Si(x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case x < 0:
+		return -Si(-x)
+	case math.IsInf(x, +1):
+		return math.Pi / 2
+	case x <= sinCosIntSwitch:
+		return siSeries(x)
+	}
+	si, _ := sinCosIntFrac(x)
+	return si
+}
+
+// Ci computes the cosine integral
+//
+//                         x
+//                         ⌠ cos(t) - 1
+//     Ci(x) = γ + ln(x) + │ —————————— dt     x > 0
+//                         ⌡     t
+//                         0
+//
+//   NOTE: (1) for x ≤ 2, the series Ci(x) = γ + ln(x) + Σ (-1)ᵏ x²ᵏ / (2k⋅(2k)!) is used
+//         (2) for x > 2, Si and Ci are obtained from the continued fraction of E1(i⋅x)
+//         (3) the first zero of Ci is at x ≈ 0.6165
+//
+//   Special cases:
+//     Ci(0)    = -Inf
+//     Ci(+Inf) = 0
+//     Ci(x)    = NaN for x < 0
+//
+func Ci(x float64) float64 {
+	switch {
+	case math.IsNaN(x) || x < 0:
+		return math.NaN()
+	case x == 0:
+		return math.Inf(-1)
+	case math.IsInf(x, +1):
+		return 0
+	case x <= sinCosIntSwitch:
+		return ciSeries(x)
+	}
+	_, ci := sinCosIntFrac(x)
+	return ci
+}
+
+// sinCosIntSwitch is the argument above which the continued fraction is used for Si and Ci
+const sinCosIntSwitch = 2.0
+
+// siSeries computes Si(x) for 0 ≤ x ≤ sinCosIntSwitch
+func siSeries(x float64) float64 {
+	x2 := x * x
+	fact := x // (-1)ᵏ x²ᵏ⁺¹ / (2k+1)!
+	sum := x
+	for k := 1; k < 100; k++ {
+		fact *= -x2 / float64((2*k)*(2*k+1))
+		term := fact / float64(2*k+1)
+		sum += term
+		if math.Abs(term) < 1e-17*math.Abs(sum) {
+			break
+		}
+	}
+	return sum
+}
+
+// ciSeries computes Ci(x) for 0 < x ≤ sinCosIntSwitch
+func ciSeries(x float64) float64 {
+	x2 := x * x
+	fact := 1.0 // (-1)ᵏ x²ᵏ / (2k)!
+	sum := 0.0
+	for k := 1; k < 100; k++ {
+		fact *= -x2 / float64((2*k-1)*(2*k))
+		term := fact / float64(2*k)
+		sum += term
+		if math.Abs(term) < 1e-17*math.Abs(sum) {
+			break
+		}
+	}
+	return EulerGamma + math.Log(x) + sum
+}
+
+// sinCosIntFrac computes Si(x) and Ci(x) for x > sinCosIntSwitch using the continued fraction
+// of E1(i⋅x) = -Ci(x) + i⋅(Si(x) - π/2) (modified Lentz method with complex arithmetic)
+func sinCosIntFrac(x float64) (si, ci float64) {
+	const tiny = 1e-300
+	b := complex(1, x)
+	C := complex(1/tiny, 0)
+	D := 1 / b
+	h := D
+	for i := 2; i < 1000; i++ {
+		a := complex(-float64((i-1)*(i-1)), 0)
+		b += 2
+		D = 1 / (a*D + b)
+		C = b + a/C
+		Δ := C * D
+		h *= Δ
+		if math.Abs(real(Δ)-1)+math.Abs(imag(Δ)) < 1e-16 {
+			break
+		}
+	}
+	h *= ExpMix(x)
+	return math.Pi/2 + imag(h), -real(h)
+}
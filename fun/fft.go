@@ -0,0 +1,319 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+	"unsafe"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// FourierTransLL computes the discrete Fourier transform (DFT) in 1D using the fast Fourier
+// transform (FFT) method (low-level implementation; i.e. without using FFTW)
+//
+//   Input:
+//     data -- real array of length 2*N with the complex values stored as pairs:
+//             data = [ real(x[0]), imag(x[0]), real(x[1]), imag(x[1]), ... ]
+//     inverse -- computes the inverse transform instead
+//
+//   Output:
+//     data -- is replaced by its (inverse) discrete Fourier transform
+//
+//   Computes:
+//                      N-1         -i 2 π j k / N                 __
+//     forward:  X[k] =  Σ  x[j] ⋅ e                     with i = √-1
+//                      j=0
+//
+//                      1  N-1         +i 2 π j k / N
+//     inverse:  x[k] = —   Σ  X[j] ⋅ e
+//                      N  j=0
+//
+//   NOTE: (1) N must be an integer power of 2
+//         (2) the inverse operation divides by N; thus a forward transform followed by an
+//             inverse transform recovers the original data
+//         (3) the algorithm is the Danielson-Lanczos method as presented in [1]
+//
+//   Reference:
+//   [1] Press WH, Teukolsky SA, Vetterling WT, Fnannery BP (2007) Numerical Recipes: The Art of
+//       Scientific Computing. Third Edition. Cambridge University Press. 1235p.
+//
+func FourierTransLL(data []float64, inverse bool) (err error) {
+
+	// check
+	nn := len(data)
+	if nn%2 != 0 {
+		return chk.Err("the length of data must be even (real and imaginary pairs). len(data) = %d is invalid", nn)
+	}
+	n := nn / 2
+	if !utl.IsPowerOfTwo(n) {
+		return chk.Err("the number of complex values N must be power of 2. N = %d is invalid", n)
+	}
+
+	fourierKernel(data, data[1:], 2, n, inverse)
+	return
+}
+
+// FourierTransChecked checks that all values in data are finite and then calls FourierTransLL
+//
+//   NOTE: (1) an error naming the first NaN or ±Inf value is returned before any computation
+//         (2) FourierTransLL does not perform this check and propagates non-finite values to
+//             the whole output; use FourierTransChecked when the input is not trusted
+//
+func FourierTransChecked(data []float64, inverse bool) (err error) {
+	for i, v := range data {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			part := "real"
+			if i%2 == 1 {
+				part = "imaginary"
+			}
+			return chk.Err("data[%d] = %v is not finite (%s part of complex value %d)", i, v, part, i/2)
+		}
+	}
+	return FourierTransLL(data, inverse)
+}
+
+// Dft computes the discrete Fourier transform (DFT) of x by means of FourierTransLL
+//
+//   x       -- complex array of length N; N must be an integer power of 2
+//   inverse -- computes the inverse transform (normalised by N) instead
+//
+//   X -- is a newly allocated array with the (inverse) DFT of x; x is not modified
+//
+func Dft(x []complex128, inverse bool) (X []complex128, err error) {
+	data := make([]float64, 2*len(x))
+	for i, v := range x {
+		data[2*i] = real(v)
+		data[2*i+1] = imag(v)
+	}
+	err = FourierTransLL(data, inverse)
+	if err != nil {
+		return
+	}
+	X = make([]complex128, len(x))
+	for i := 0; i < len(x); i++ {
+		X[i] = complex(data[2*i], data[2*i+1])
+	}
+	return
+}
+
+// DftInplace computes the discrete Fourier transform (DFT) of x by means of FourierTransLL
+// and replaces x by the result. No memory is allocated.
+//
+//   x       -- [modified] complex array of length N; N must be an integer power of 2
+//   inverse -- computes the inverse transform (normalised by N) instead
+//
+func DftInplace(x []complex128, inverse bool) (err error) {
+	return FourierTransLL(complexAsPairs(x), inverse)
+}
+
+// DftSplit computes the discrete Fourier transform (DFT) of data held as separate real and
+// imaginary arrays; i.e. x[j] = re[j] + i⋅im[j]. The algorithm is the same as in FourierTransLL
+//
+//   re, im  -- [modified] real and imaginary parts; both with length N, an integer power of 2
+//   inverse -- computes the inverse transform (normalised by N) instead
+//
+//   NOTE: (1) no memory is allocated and no interleaving is performed
+//         (2) DftSplit is not faster than interleaving the data, calling FourierTransLL and
+//             deinterleaving the result: both take about the same time with N = 2¹⁶ (see
+//             BenchmarkDftSplit). The advantage of DftSplit is that no temporary array is needed
+//
+func DftSplit(re, im []float64, inverse bool) (err error) {
+
+	// check
+	n := len(re)
+	if len(im) != n {
+		return chk.Err("the real and imaginary arrays must have the same length. %d != %d", n, len(im))
+	}
+	if !utl.IsPowerOfTwo(n) {
+		return chk.Err("the number of complex values N must be power of 2. N = %d is invalid", n)
+	}
+
+	fourierKernel(re, im, 1, n, inverse)
+	return
+}
+
+// fourierTile is the number of consecutive butterflies processed with precomputed twiddle factors
+const fourierTile = 16
+
+// fourierKernel implements FourierTransLL for N complex values whose real and imaginary parts
+// are found at re[s*k] and im[s*k], k = 0 ... N-1; i.e. s = 2 and im = re[1:] for data stored
+// as pairs and s = 1 for separate arrays. The butterflies with the same twiddle factor are
+// processed in tiles of fourierTile consecutive indices, such that each block is traversed
+// contiguously; this avoids the large power-of-two strides of the textbook loop order
+func fourierKernel(re, im []float64, s, n int, inverse bool) {
+
+	// bit reversal section
+	j := 0
+	for i := 0; i < n; i++ {
+		if j > i {
+			re[s*j], re[s*i] = re[s*i], re[s*j]
+			im[s*j], im[s*i] = im[s*i], im[s*j]
+		}
+		m := n >> 1
+		for m >= 1 && j&m != 0 {
+			j ^= m
+			m >>= 1
+		}
+		j |= m
+	}
+
+	// Danielson-Lanczos section
+	sign := -1.0
+	if inverse {
+		sign = 1.0
+	}
+	var wtemp, wr, wpr, wpi, wi, θ, tempr, tempi float64
+	var wrs, wis [fourierTile]float64
+	var i, nt int
+	for mmax := 1; mmax < n; mmax <<= 1 {
+		istep := mmax << 1
+		θ = sign * math.Pi / float64(mmax)
+		wtemp = math.Sin(0.5 * θ)
+		wpr = -2.0 * wtemp * wtemp
+		wpi = math.Sin(θ)
+		wr = 1.0
+		wi = 0.0
+		for m0 := 0; m0 < mmax; m0 += fourierTile {
+			nt = utl.Imin(fourierTile, mmax-m0)
+			for t := 0; t < nt; t++ {
+				wrs[t], wis[t] = wr, wi
+				wtemp = wr
+				wr = wr*wpr - wi*wpi + wr // trigonometric recurrence
+				wi = wi*wpr + wtemp*wpi + wi
+			}
+			for i0 := s * m0; i0 < s*n; i0 += s * istep {
+				i, j = i0, i0+s*mmax
+				for t := 0; t < nt; t++ {
+					tempr = wrs[t]*re[j] - wis[t]*im[j]
+					tempi = wrs[t]*im[j] + wis[t]*re[j]
+					re[j] = re[i] - tempr
+					im[j] = im[i] - tempi
+					re[i] += tempr
+					im[i] += tempi
+					i += s
+					j += s
+				}
+			}
+		}
+	}
+
+	// normalise
+	if inverse {
+		den := float64(n)
+		for i = 0; i < n; i++ {
+			re[s*i] /= den
+			im[s*i] /= den
+		}
+	}
+}
+
+// RealFourierTrans computes the discrete Fourier transform (DFT) of a real array x and returns
+// only the non-redundant half of the spectrum; i.e. the bins k = 0, 1, ..., N/2
+//
+//   x -- real array of length N; N must be an integer power of 2
+//
+//   X -- complex array of length N/2+1 with X[k] = Σ x[j]⋅exp(-i 2 π j k / N)
+//        The other bins follow from the Hermitian symmetry: X[N-k] = conj(X[k])
+//
+//   NOTE: the even and odd samples of x are packed as the real and imaginary parts of a complex
+//         array of length N/2; thus only one FFT of size N/2 is computed
+//
+func RealFourierTrans(x []float64) (X []complex128, err error) {
+
+	// check
+	N := len(x)
+	if !utl.IsPowerOfTwo(N) {
+		return nil, chk.Err("the length of x must be power of 2. N = %d is invalid", N)
+	}
+	if N == 1 {
+		return []complex128{complex(x[0], 0)}, nil
+	}
+
+	// pack even and odd samples and compute FFT of size N/2
+	M := N / 2
+	z := make([]complex128, M)
+	for k := 0; k < M; k++ {
+		z[k] = complex(x[2*k], x[2*k+1])
+	}
+	err = DftInplace(z, false)
+	if err != nil {
+		return
+	}
+
+	// separate the transforms of even (E) and odd (O) samples and combine them
+	X = make([]complex128, M+1)
+	var zk, zmk, E, O complex128
+	for k := 0; k <= M; k++ {
+		zk, zmk = z[k%M], cmplx.Conj(z[(M-k)%M])
+		E = (zk + zmk) / 2
+		O = (zk - zmk) / 2i
+		X[k] = E + ExpMix(2.0*math.Pi*float64(k)/float64(N))*O
+	}
+	return
+}
+
+// RealFourierTransInv computes the inverse discrete Fourier transform of the half spectrum of a
+// real array, as computed by RealFourierTrans
+//
+//   spectrum -- complex array of length n/2+1 with the bins k = 0, 1, ..., n/2
+//   n        -- length of the real signal; n must be an integer power of 2
+//
+//   x -- real array of length n with x[j] = (1/n) Σ X[k]⋅exp(+i 2 π j k / n) where the sum is
+//        over all n bins and the missing bins are given by X[n-k] = conj(X[k])
+//
+//   NOTE: the imaginary parts of spectrum[0] and spectrum[n/2] are ignored
+//
+func RealFourierTransInv(spectrum []complex128, n int) (x []float64, err error) {
+
+	// check
+	if !utl.IsPowerOfTwo(n) {
+		return nil, chk.Err("the length of the real signal must be power of 2. n = %d is invalid", n)
+	}
+	if len(spectrum) != n/2+1 {
+		return nil, chk.Err("the length of the half spectrum must be n/2+1 = %d. len(spectrum) = %d is invalid", n/2+1, len(spectrum))
+	}
+	if n == 1 {
+		return []float64{real(spectrum[0])}, nil
+	}
+
+	// build the transform of the packed array (even samples + i odd samples)
+	M := n / 2
+	z := make([]complex128, M)
+	var Xk, Xmk, E, O complex128
+	for k := 0; k < M; k++ {
+		Xk, Xmk = spectrum[k], cmplx.Conj(spectrum[M-k])
+		if k == 0 {
+			Xk = complex(real(Xk), 0)
+			Xmk = complex(real(Xmk), 0)
+		}
+		E = (Xk + Xmk) / 2
+		O = (Xk - Xmk) / 2 * ExpPix(2.0*math.Pi*float64(k)/float64(n))
+		z[k] = E + 1i*O
+	}
+	err = DftInplace(z, true)
+	if err != nil {
+		return
+	}
+
+	// unpack
+	x = make([]float64, n)
+	for k := 0; k < M; k++ {
+		x[2*k] = real(z[k])
+		x[2*k+1] = imag(z[k])
+	}
+	return
+}
+
+// complexAsPairs returns a view to x as a real array with the [real, imag] pairs of each value.
+// The memory of x is shared (not copied)
+func complexAsPairs(x []complex128) []float64 {
+	if len(x) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*float64)(unsafe.Pointer(&x[0])), 2*len(x))
+}
@@ -0,0 +1,123 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// FourierTransLL32 computes the discrete Fourier transform (DFT) in 1D of single precision data
+// using the FFT method. See FourierTransLL
+//
+//   Input:
+//     data -- real array of length 2*N with the complex values stored as pairs:
+//             data = [ real(x[0]), imag(x[0]), real(x[1]), imag(x[1]), ... ]
+//     inverse -- computes the inverse transform (normalised by N) instead
+//
+//   Output:
+//     data -- is replaced by its (inverse) discrete Fourier transform
+//
+//   NOTE: (1) N must be an integer power of 2
+//         (2) the butterflies are computed in single precision; thus the relative error of the
+//             results is about 1e-7 ⋅ log2(N) (compared with 1e-16 ⋅ log2(N) of FourierTransLL).
+//             The twiddle factors are computed by the trigonometric recurrence in double
+//             precision to avoid the accumulation of errors
+//
+func FourierTransLL32(data []float32, inverse bool) (err error) {
+
+	// check
+	nn := len(data)
+	if nn%2 != 0 {
+		return chk.Err("the length of data must be even (real and imaginary pairs). len(data) = %d is invalid", nn)
+	}
+	n := nn / 2
+	if !utl.IsPowerOfTwo(n) {
+		return chk.Err("the number of complex values N must be power of 2. N = %d is invalid", n)
+	}
+
+	// bit reversal section
+	j := 0
+	for i := 0; i < n; i++ {
+		if j > i {
+			data[2*j], data[2*i] = data[2*i], data[2*j]
+			data[2*j+1], data[2*i+1] = data[2*i+1], data[2*j+1]
+		}
+		m := n >> 1
+		for m >= 1 && j&m != 0 {
+			j ^= m
+			m >>= 1
+		}
+		j |= m
+	}
+
+	// Danielson-Lanczos section
+	sign := -1.0
+	if inverse {
+		sign = 1.0
+	}
+	var wtemp, wr, wpr, wpi, wi, θ float64
+	var wr32, wi32, tempr, tempi float32
+	for mmax := 1; mmax < n; mmax <<= 1 {
+		istep := mmax << 1
+		θ = sign * math.Pi / float64(mmax)
+		wtemp = math.Sin(0.5 * θ)
+		wpr = -2.0 * wtemp * wtemp
+		wpi = math.Sin(θ)
+		wr = 1.0
+		wi = 0.0
+		for m := 0; m < mmax; m++ {
+			wr32, wi32 = float32(wr), float32(wi)
+			for i := m; i < n; i += istep {
+				j = i + mmax
+				tempr = wr32*data[2*j] - wi32*data[2*j+1]
+				tempi = wr32*data[2*j+1] + wi32*data[2*j]
+				data[2*j] = data[2*i] - tempr
+				data[2*j+1] = data[2*i+1] - tempi
+				data[2*i] += tempr
+				data[2*i+1] += tempi
+			}
+			wtemp = wr
+			wr = wr*wpr - wi*wpi + wr // trigonometric recurrence
+			wi = wi*wpr + wtemp*wpi + wi
+		}
+	}
+
+	// normalise
+	if inverse {
+		den := float32(n)
+		for i := 0; i < nn; i++ {
+			data[i] /= den
+		}
+	}
+	return
+}
+
+// Dft32 computes the discrete Fourier transform (DFT) of single precision data by means of
+// FourierTransLL32
+//
+//   x       -- complex array of length N; N must be an integer power of 2
+//   inverse -- computes the inverse transform (normalised by N) instead
+//
+//   X -- is a newly allocated array with the (inverse) DFT of x; x is not modified
+//
+func Dft32(x []complex64, inverse bool) (X []complex64, err error) {
+	data := make([]float32, 2*len(x))
+	for i, v := range x {
+		data[2*i] = real(v)
+		data[2*i+1] = imag(v)
+	}
+	err = FourierTransLL32(data, inverse)
+	if err != nil {
+		return
+	}
+	X = make([]complex64, len(x))
+	for i := 0; i < len(x); i++ {
+		X[i] = complex(data[2*i], data[2*i+1])
+	}
+	return
+}
@@ -0,0 +1,244 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// FftShift rotates the spectrum X such that the zero-frequency component is moved to the centre
+//
+//   Example:
+//     X = [X0, X1, X2, X3, X4, X5, X6, X7]  ⇒  [X4, X5, X6, X7, X0, X1, X2, X3]
+//     X = [X0, X1, X2, X3, X4]              ⇒  [X3, X4, X0, X1, X2]
+//
+//   NOTE: this function is equivalent to numpy.fft.fftshift; a new array is returned
+//
+func FftShift(X []complex128) (Y []complex128) {
+	n := len(X)
+	Y = make([]complex128, n)
+	for i := 0; i < n; i++ {
+		Y[(i+n/2)%n] = X[i]
+	}
+	return
+}
+
+// IfftShift undoes the rotation performed by FftShift; i.e. IfftShift(FftShift(X)) = X
+//
+//   Example:
+//     X = [X3, X4, X0, X1, X2]  ⇒  [X0, X1, X2, X3, X4]
+//
+//   NOTE: this function is equivalent to numpy.fft.ifftshift; a new array is returned
+//
+func IfftShift(X []complex128) (Y []complex128) {
+	n := len(X)
+	Y = make([]complex128, n)
+	for i := 0; i < n; i++ {
+		Y[i] = X[(i+n/2)%n]
+	}
+	return
+}
+
+// BitReverseIndices returns the bit-reversal permutation of 0 ... n-1; i.e. p[i] is the number
+// obtained by reversing the log₂(n) bits of i
+//
+//   Example:
+//     n = 8  ⇒  p = [0, 4, 2, 6, 1, 5, 3, 7]
+//
+//   NOTE: (1) n must be an integer power of 2
+//         (2) this is the reordering performed by FourierTransLL before the butterflies
+//         (3) the permutation is its own inverse; i.e. p[p[i]] = i
+//
+func BitReverseIndices(n int) (p []int) {
+	if !utl.IsPowerOfTwo(n) {
+		chk.Panic("n must be power of 2. n = %d is invalid\n", n)
+	}
+	p = make([]int, n)
+	j := 0
+	for i := 0; i < n; i++ {
+		p[i] = j
+		m := n >> 1
+		for m >= 1 && j&m != 0 {
+			j ^= m
+			m >>= 1
+		}
+		j |= m
+	}
+	return
+}
+
+// BitReversePermute reorders data in place according to the bit-reversal permutation; see
+// BitReverseIndices
+//   NOTE: len(data) must be an integer power of 2
+func BitReversePermute(data []complex128) {
+	p := BitReverseIndices(len(data))
+	for i, j := range p {
+		if j > i {
+			data[i], data[j] = data[j], data[i]
+		}
+	}
+}
+
+// FftFreq returns the sample frequencies of the bins of an n-point DFT with sample spacing d
+//
+//   Output (as numpy.fft.fftfreq):
+//     f = [0, 1, ..., n/2-1, -n/2, ..., -1] / (d⋅n)        if n is even
+//     f = [0, 1, ..., (n-1)/2, -(n-1)/2, ..., -1] / (d⋅n)  if n is odd
+//
+//   NOTE: if d is the time step in seconds, the frequencies are in cycles per second (Hz)
+//
+func FftFreq(n int, d float64) (f []float64) {
+	f = make([]float64, n)
+	den := d * float64(n)
+	for k := 0; k < n; k++ {
+		if k < (n+1)/2 {
+			f[k] = float64(k) / den
+		} else {
+			f[k] = float64(k-n) / den
+		}
+	}
+	return
+}
+
+// RfftFreq returns the (non-negative) sample frequencies of the half spectrum computed by
+// RealFourierTrans for an n-point signal with sample spacing d
+//
+//   Output (as numpy.fft.rfftfreq):
+//     f = [0, 1, ..., n/2] / (d⋅n)   ⇒   len(f) = n/2 + 1
+//
+func RfftFreq(n int, d float64) (f []float64) {
+	f = make([]float64, n/2+1)
+	den := d * float64(n)
+	for k := 0; k < len(f); k++ {
+		f[k] = float64(k) / den
+	}
+	return
+}
+
+// ZeroPad returns a new array of length n with x copied into the front and zeros in the remainder
+//
+//   x -- real array
+//   n -- length of the result; n ≥ len(x) and n must be an integer power of 2
+//
+//   Example:
+//     x = [1, 2, 3] and n = 8  ⇒  [1, 2, 3, 0, 0, 0, 0, 0]
+//
+//   NOTE: this is useful to compute linear convolutions or to interpolate the spectrum of x
+//
+func ZeroPad(x []float64, n int) (y []float64, err error) {
+	if err = checkZeroPad(len(x), n); err != nil {
+		return
+	}
+	y = make([]float64, n)
+	copy(y, x)
+	return
+}
+
+// ZeroPadComplex returns a new array of length n with x copied into the front and zeros in the
+// remainder; n ≥ len(x) and n must be an integer power of 2. See ZeroPad
+func ZeroPadComplex(x []complex128, n int) (y []complex128, err error) {
+	if err = checkZeroPad(len(x), n); err != nil {
+		return
+	}
+	y = make([]complex128, n)
+	copy(y, x)
+	return
+}
+
+// checkZeroPad checks the arguments of ZeroPad and ZeroPadComplex
+func checkZeroPad(m, n int) error {
+	if n < m {
+		return chk.Err("the padded length must be greater than or equal to len(x) = %d. n = %d is invalid", m, n)
+	}
+	if !utl.IsPowerOfTwo(n) {
+		return chk.Err("the padded length must be power of 2. n = %d is invalid", n)
+	}
+	return nil
+}
+
+// Magnitude returns the absolute values |X[k]| of the bins of a spectrum
+func Magnitude(spectrum []complex128) (mag []float64) {
+	mag = make([]float64, len(spectrum))
+	for k, X := range spectrum {
+		mag[k] = cmplx.Abs(X)
+	}
+	return
+}
+
+// Phase returns the arguments atan2(imag(X[k]), real(X[k])) ∈ [-π, π] of the bins of a spectrum
+//   NOTE: the phase of a zero bin is 0; see also Unwrap
+func Phase(spectrum []complex128) (phase []float64) {
+	phase = make([]float64, len(spectrum))
+	for k, X := range spectrum {
+		phase[k] = math.Atan2(imag(X), real(X))
+	}
+	return
+}
+
+// MagnitudeDB returns the magnitudes of the bins of a spectrum in decibels
+//
+//   dB[k] = 20 log10(|X[k]| / ref)
+//
+//   NOTE: (1) the result is -Inf for zero bins; thus, the values may be clamped afterwards
+//             to a minimum (e.g. -120 dB) when plotting
+//         (2) ref must be positive; e.g. ref = max|X| gives 0 dB at the peak
+//
+func MagnitudeDB(spectrum []complex128, ref float64) (dB []float64) {
+	if ref <= 0 {
+		chk.Panic("the reference value must be positive. ref = %g is invalid", ref)
+	}
+	dB = make([]float64, len(spectrum))
+	for k, X := range spectrum {
+		a := cmplx.Abs(X)
+		if a == 0 {
+			dB[k] = math.Inf(-1)
+			continue
+		}
+		dB[k] = 20.0 * math.Log10(a/ref)
+	}
+	return
+}
+
+// Unwrap removes the jumps greater than π between consecutive values of phase by adding
+// multiples of 2π; i.e. Unwrap(phase) = UnwrapDiscont(phase, π)
+func Unwrap(phase []float64) []float64 {
+	return UnwrapDiscont(phase, math.Pi)
+}
+
+// UnwrapDiscont removes the jumps between consecutive values of phase greater than discont by
+// adding multiples of 2π
+//
+//   NOTE: (1) this function is equivalent to numpy.unwrap; values of discont smaller than π
+//             are treated as π
+//         (2) a new array is returned
+//
+func UnwrapDiscont(phase []float64, discont float64) (res []float64) {
+	res = make([]float64, len(phase))
+	if len(phase) == 0 {
+		return
+	}
+	res[0] = phase[0]
+	correction := 0.0
+	for i := 1; i < len(phase); i++ {
+		d := phase[i] - phase[i-1]
+		dmod := math.Mod(d+math.Pi, 2*math.Pi)
+		if dmod < 0 {
+			dmod += 2 * math.Pi
+		}
+		dmod -= math.Pi
+		if dmod == -math.Pi && d > 0 {
+			dmod = math.Pi
+		}
+		if math.Abs(d) >= discont {
+			correction += dmod - d
+		}
+		res[i] = phase[i] + correction
+	}
+	return
+}
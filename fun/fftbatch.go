@@ -0,0 +1,108 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// BatchDft computes the discrete Fourier transform (DFT) of many independent rows concurrently
+// by means of FourierTransLL
+//
+//   rows     -- [modified] set of real arrays with real and imaginary pairs (see FourierTransLL);
+//               the rows may have different lengths, but each one must hold a power of 2 number
+//               of complex values
+//   inverse  -- computes the inverse transform (normalised by N) instead
+//   nWorkers -- number of goroutines transforming rows; use 0 for runtime.NumCPU()
+//
+//   NOTE: all rows are processed even if some of them fail; the errors of all failed rows are
+//         returned together in a single error
+//
+func BatchDft(rows [][]float64, inverse bool, nWorkers int) (err error) {
+	return BatchDftContext(context.Background(), rows, inverse, nWorkers)
+}
+
+// BatchDftContext computes the DFT of many independent rows concurrently, as BatchDft, but stops
+// when the context ctx is cancelled
+//
+//   ctx -- context; ctx.Done() is checked before each row is transformed
+//
+//   NOTE: (1) when ctx is cancelled, no more rows are started; the rows being transformed are
+//             completed and then ctx.Err() is returned. The remaining rows are not modified
+//         (2) see BatchDft for the other arguments
+//
+func BatchDftContext(ctx context.Context, rows [][]float64, inverse bool, nWorkers int) (err error) {
+	return batchRun(ctx, len(rows), nWorkers, func(i int) error {
+		return FourierTransLL(rows[i], inverse)
+	})
+}
+
+// batchRun calls fcn(i) for i = 0 ... nrows-1 concurrently with nWorkers goroutines until ctx is
+// cancelled; the errors of all rows are collected by joinRowErrors
+func batchRun(ctx context.Context, nrows, nWorkers int, fcn func(i int) error) (err error) {
+
+	// check
+	if nWorkers < 0 {
+		return chk.Err("the number of workers must be non-negative. nWorkers = %d is invalid", nWorkers)
+	}
+	if nWorkers == 0 {
+		nWorkers = runtime.NumCPU()
+	}
+
+	// run workers
+	errs := make([]error, nrows)
+	jobs := make(chan int)
+	wg := new(sync.WaitGroup)
+	wg.Add(nWorkers)
+	for w := 0; w < nWorkers; w++ {
+		go func() {
+			for i := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				errs[i] = fcn(i)
+			}
+			wg.Done()
+		}()
+	}
+	done := ctx.Done()
+dispatch:
+	for i := 0; i < nrows; i++ {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case jobs <- i:
+		case <-done:
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	return joinRowErrors(errs)
+}
+
+// joinRowErrors collects the non-nil errors of each row in a single error
+func joinRowErrors(errs []error) error {
+	var msgs []string
+	for i, e := range errs {
+		if e != nil {
+			msgs = append(msgs, io.Sf("row %d: %v", i, e))
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return chk.Err("%d of %d rows failed:\n%s", len(msgs), len(errs), strings.Join(msgs, "\n"))
+}
@@ -0,0 +1,51 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import "github.com/cpmech/gosl/chk"
+
+// IdealFilter applies an ideal (brick-wall) low-pass or high-pass filter to x in the frequency
+// domain
+//
+//   x        -- real signal with N samples; N must be an integer power of 2
+//   fs       -- sampling frequency (e.g. in Hz)
+//   cutoff   -- cutoff frequency; 0 ≤ cutoff ≤ fs/2
+//   highpass -- removes the frequencies below cutoff instead of the frequencies above cutoff
+//
+//   Output:
+//     y -- filtered signal with N samples
+//
+//   NOTE: (1) the half spectrum computed by RealFourierTrans is modified; thus the bins of the
+//             mirrored negative frequencies are handled implicitly and y is real
+//         (2) the bins with frequency exactly equal to cutoff are kept
+//         (3) the abrupt cut causes ringing (Gibbs phenomenon) if the signal has energy near
+//             the cutoff frequency
+//
+func IdealFilter(x []float64, fs, cutoff float64, highpass bool) (y []float64, err error) {
+
+	// check
+	N := len(x)
+	if fs <= 0 {
+		return nil, chk.Err("the sampling frequency must be positive. fs = %g is invalid", fs)
+	}
+	if cutoff < 0 || cutoff > fs/2 {
+		return nil, chk.Err("the cutoff frequency must be in [0, fs/2] = [0, %g]. cutoff = %g is invalid", fs/2, cutoff)
+	}
+
+	// spectrum
+	X, err := RealFourierTrans(x)
+	if err != nil {
+		return
+	}
+
+	// remove bins
+	freqs := RfftFreq(N, 1.0/fs)
+	for k, f := range freqs {
+		if (highpass && f < cutoff) || (!highpass && f > cutoff) {
+			X[k] = 0
+		}
+	}
+	return RealFourierTransInv(X, N)
+}
@@ -0,0 +1,127 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// Dft2d computes the discrete Fourier transform (DFT) in 2D by means of FourierTransLL
+//
+//   data    -- [modified] matrix with m rows and n columns: data[m][n]; all rows must have the
+//              same length and both m and n must be integer powers of 2
+//   inverse -- computes the inverse transform (normalised by m⋅n) instead
+//
+//   Computes:
+//                         m-1 n-1            -i 2 π (j k / m + l p / n)
+//     forward:  X[k][p] =  Σ   Σ  x[j][l] ⋅ e
+//                         j=0 l=0
+//
+//   NOTE: the transform is separable; thus all rows are transformed first and then all columns
+//
+func Dft2d(data [][]complex128, inverse bool) (err error) {
+
+	// check
+	m := len(data)
+	if !utl.IsPowerOfTwo(m) {
+		return chk.Err("the number of rows must be power of 2. m = %d is invalid", m)
+	}
+	n := len(data[0])
+	if !utl.IsPowerOfTwo(n) {
+		return chk.Err("the number of columns must be power of 2. n = %d is invalid", n)
+	}
+	for i := 1; i < m; i++ {
+		if len(data[i]) != n {
+			return chk.Err("all rows must have the same length. len(data[%d]) = %d is different than n = %d", i, len(data[i]), n)
+		}
+	}
+
+	// transform rows
+	for i := 0; i < m; i++ {
+		err = DftInplace(data[i], inverse)
+		if err != nil {
+			return
+		}
+	}
+
+	// transform columns
+	column := make([]complex128, m)
+	for j := 0; j < n; j++ {
+		for i := 0; i < m; i++ {
+			column[i] = data[i][j]
+		}
+		err = DftInplace(column, inverse)
+		if err != nil {
+			return
+		}
+		for i := 0; i < m; i++ {
+			data[i][j] = column[i]
+		}
+	}
+	return
+}
+
+// DftNd computes the discrete Fourier transform (DFT) in N dimensions by means of FourierTransLL
+//
+//   data    -- [modified] array with all values stored in row-major order; e.g. in 3D with
+//              shape = [n0, n1, n2]: x[i][j][k] = data[i*n1*n2 + j*n2 + k]
+//   shape   -- dimensions of the array; all must be integer powers of 2 and their product must
+//              be equal to len(data)
+//   inverse -- computes the inverse transform (normalised by len(data)) instead
+//
+//   NOTE: the transform is computed along each axis in turn
+//
+func DftNd(data []complex128, shape []int, inverse bool) (err error) {
+
+	// check
+	if len(shape) < 1 {
+		return chk.Err("shape must have at least one dimension")
+	}
+	size := 1
+	for i, n := range shape {
+		if !utl.IsPowerOfTwo(n) {
+			return chk.Err("all dimensions must be power of 2. shape[%d] = %d is invalid", i, n)
+		}
+		size *= n
+	}
+	if size != len(data) {
+		return chk.Err("the product of dimensions (%d) must be equal to len(data) = %d", size, len(data))
+	}
+
+	// transform along each axis
+	var line []complex128
+	stride := size
+	for _, n := range shape {
+		stride /= n // distance between consecutive values along this axis
+		if stride == 1 {
+			for start := 0; start < size; start += n {
+				err = DftInplace(data[start:start+n], inverse)
+				if err != nil {
+					return
+				}
+			}
+			continue
+		}
+		if len(line) < n {
+			line = make([]complex128, n)
+		}
+		for block := 0; block < size; block += n * stride {
+			for offset := 0; offset < stride; offset++ {
+				for i := 0; i < n; i++ {
+					line[i] = data[block+offset+i*stride]
+				}
+				err = DftInplace(line[:n], inverse)
+				if err != nil {
+					return
+				}
+				for i := 0; i < n; i++ {
+					data[block+offset+i*stride] = line[i]
+				}
+			}
+		}
+	}
+	return
+}
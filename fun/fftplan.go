@@ -0,0 +1,242 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// FourierPlan holds precomputed data to perform many FFTs of the same size N with the same
+// algorithm as FourierTransLL; i.e. the bit-reversal permutation and the twiddle factors
+//
+//   NOTE: Transform does not allocate memory; thus a plan can be reused as many times as needed
+//
+type FourierPlan struct {
+	N     int       // number of complex values; len(data) = 2*N
+	swaps []int     // pairs of indices (i,j) to be swapped in the bit reversal section
+	cos   []float64 // cos(2 π k / N) for k = 0 ... N/2-1
+	sin   []float64 // sin(2 π k / N) for k = 0 ... N/2-1
+}
+
+// NewFourierPlan returns a new plan to compute FFTs of N complex values (2*N reals)
+//   N -- number of complex values; N must be an integer power of 2
+func NewFourierPlan(N int) (o *FourierPlan, err error) {
+
+	// check
+	if !utl.IsPowerOfTwo(N) {
+		return nil, chk.Err("the number of complex values N must be power of 2. N = %d is invalid", N)
+	}
+
+	// bit reversal permutation
+	o = new(FourierPlan)
+	o.N = N
+	j := 0
+	for i := 0; i < N; i++ {
+		if j > i {
+			o.swaps = append(o.swaps, i, j)
+		}
+		m := N >> 1
+		for m >= 1 && j&m != 0 {
+			j ^= m
+			m >>= 1
+		}
+		j |= m
+	}
+
+	// twiddle factors
+	o.cos = make([]float64, N/2)
+	o.sin = make([]float64, N/2)
+	for k := 0; k < N/2; k++ {
+		θ := 2.0 * math.Pi * float64(k) / float64(N)
+		o.cos[k] = math.Cos(θ)
+		o.sin[k] = math.Sin(θ)
+	}
+	return
+}
+
+// Transform computes the discrete Fourier transform (DFT) of data; see FourierTransLL
+//
+//   data    -- [modified] real array of length 2*N with the complex values stored as pairs:
+//              data = [ real(x[0]), imag(x[0]), real(x[1]), imag(x[1]), ... ]
+//   inverse -- computes the inverse transform (normalised by N) instead
+//
+func (o *FourierPlan) Transform(data []float64, inverse bool) (err error) {
+
+	// check
+	if len(data) != 2*o.N {
+		return chk.Err("the length of data must be equal to 2*N = %d. len(data) = %d is invalid", 2*o.N, len(data))
+	}
+
+	// bit reversal section
+	var i, j int
+	for k := 0; k < len(o.swaps); k += 2 {
+		i, j = o.swaps[k], o.swaps[k+1]
+		data[2*j], data[2*i] = data[2*i], data[2*j]
+		data[2*j+1], data[2*i+1] = data[2*i+1], data[2*j+1]
+	}
+
+	// Danielson-Lanczos section
+	sign := -1.0
+	if inverse {
+		sign = 1.0
+	}
+	var wr, wi, tempr, tempi float64
+	for mmax := 1; mmax < o.N; mmax <<= 1 {
+		istep := mmax << 1
+		step := o.N / istep // stride in the table of twiddle factors
+		for m := 0; m < mmax; m++ {
+			wr = o.cos[m*step]
+			wi = sign * o.sin[m*step]
+			for i = m; i < o.N; i += istep {
+				j = i + mmax
+				tempr = wr*data[2*j] - wi*data[2*j+1]
+				tempi = wr*data[2*j+1] + wi*data[2*j]
+				data[2*j] = data[2*i] - tempr
+				data[2*j+1] = data[2*i+1] - tempi
+				data[2*i] += tempr
+				data[2*i+1] += tempi
+			}
+		}
+	}
+
+	// normalise
+	if inverse {
+		den := float64(o.N)
+		for i = 0; i < len(data); i++ {
+			data[i] /= den
+		}
+	}
+	return
+}
+
+// RealFFT holds a plan, scratch memory and twiddle factors to compute many FFTs of real arrays
+// of the same length n without allocating memory; see RealFourierTrans and RealFourierTransInv
+//
+//   NOTE: Forward and Inverse write into the scratch memory; thus a RealFFT is not safe for
+//         concurrent use. Create one RealFFT per goroutine
+//
+type RealFFT struct {
+	N    int          // length of the real arrays
+	plan *FourierPlan // plan for the complex FFT of size N/2
+	z    []complex128 // scratch: packed even (real) and odd (imaginary) samples
+	w    []complex128 // twiddle factors exp(-i 2 π k / N) for k = 0 ... N/2
+}
+
+// NewRealFFT returns a new RealFFT for real arrays of length n; n must be an integer power of 2
+func NewRealFFT(n int) (o *RealFFT) {
+	if !utl.IsPowerOfTwo(n) {
+		chk.Panic("the length of the real arrays must be power of 2. n = %d is invalid\n", n)
+	}
+	o = &RealFFT{N: n}
+	if n == 1 {
+		return
+	}
+	M := n / 2
+	var err error
+	o.plan, err = NewFourierPlan(M)
+	if err != nil {
+		chk.Panic("%v\n", err)
+	}
+	o.z = make([]complex128, M)
+	o.w = make([]complex128, M+1)
+	for k := 0; k <= M; k++ {
+		o.w[k] = ExpMix(2.0 * math.Pi * float64(k) / float64(n))
+	}
+	return
+}
+
+// Forward computes the half spectrum of the real array x; see RealFourierTrans
+//
+//   x   -- real array of length N
+//   out -- [modified] complex array of length N/2+1 with out[k] = Σ x[j]⋅exp(-i 2 π j k / N)
+//
+//   NOTE: no memory is allocated
+//
+func (o *RealFFT) Forward(x []float64, out []complex128) (err error) {
+
+	// check
+	if len(x) != o.N {
+		return chk.Err("the length of x must be equal to N = %d. len(x) = %d is invalid", o.N, len(x))
+	}
+	if len(out) != o.N/2+1 {
+		return chk.Err("the length of out must be equal to N/2+1 = %d. len(out) = %d is invalid", o.N/2+1, len(out))
+	}
+	if o.N == 1 {
+		out[0] = complex(x[0], 0)
+		return
+	}
+
+	// pack even and odd samples and compute FFT of size N/2
+	M := o.N / 2
+	for k := 0; k < M; k++ {
+		o.z[k] = complex(x[2*k], x[2*k+1])
+	}
+	err = o.plan.Transform(complexAsPairs(o.z), false)
+	if err != nil {
+		return
+	}
+
+	// separate the transforms of even (E) and odd (O) samples and combine them
+	var zk, zmk, E, O complex128
+	for k := 0; k <= M; k++ {
+		zk, zmk = o.z[k%M], cmplx.Conj(o.z[(M-k)%M])
+		E = (zk + zmk) / 2
+		O = (zk - zmk) / 2i
+		out[k] = E + o.w[k]*O
+	}
+	return
+}
+
+// Inverse computes the real array corresponding to a half spectrum; see RealFourierTransInv
+//
+//   spectrum -- complex array of length N/2+1 with the bins k = 0, 1, ..., N/2. The imaginary
+//               parts of spectrum[0] and spectrum[N/2] are ignored
+//   out      -- [modified] real array of length N with the inverse transform (normalised by N)
+//
+//   NOTE: no memory is allocated
+//
+func (o *RealFFT) Inverse(spectrum []complex128, out []float64) (err error) {
+
+	// check
+	if len(spectrum) != o.N/2+1 {
+		return chk.Err("the length of the half spectrum must be N/2+1 = %d. len(spectrum) = %d is invalid", o.N/2+1, len(spectrum))
+	}
+	if len(out) != o.N {
+		return chk.Err("the length of out must be equal to N = %d. len(out) = %d is invalid", o.N, len(out))
+	}
+	if o.N == 1 {
+		out[0] = real(spectrum[0])
+		return
+	}
+
+	// build the transform of the packed array (even samples + i odd samples)
+	M := o.N / 2
+	var Xk, Xmk, E, O complex128
+	for k := 0; k < M; k++ {
+		Xk, Xmk = spectrum[k], cmplx.Conj(spectrum[M-k])
+		if k == 0 {
+			Xk = complex(real(Xk), 0)
+			Xmk = complex(real(Xmk), 0)
+		}
+		E = (Xk + Xmk) / 2
+		O = (Xk - Xmk) / 2 * cmplx.Conj(o.w[k])
+		o.z[k] = E + 1i*O
+	}
+	err = o.plan.Transform(complexAsPairs(o.z), true)
+	if err != nil {
+		return
+	}
+
+	// unpack
+	for k := 0; k < M; k++ {
+		out[2*k] = real(o.z[k])
+		out[2*k+1] = imag(o.z[k])
+	}
+	return
+}
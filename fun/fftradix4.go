@@ -0,0 +1,123 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// FourierTransFast computes the discrete Fourier transform (DFT) in 1D; see FourierTransLL
+//
+//   Input:
+//     data -- real array of length 2*N with the complex values stored as pairs:
+//             data = [ real(x[0]), imag(x[0]), real(x[1]), imag(x[1]), ... ]
+//     inverse -- computes the inverse transform instead
+//
+//   Output:
+//     data -- is replaced by its (inverse) discrete Fourier transform
+//
+//   NOTE: (1) N must be an integer power of 2
+//         (2) if N is a power of 4, a radix-4 decimation-in-time algorithm is used; it requires
+//             about 25% fewer real multiplications than the radix-2 algorithm. Otherwise,
+//             FourierTransLL is called
+//         (3) the results are the same as the ones computed by FourierTransLL, apart from
+//             round-off errors
+//
+func FourierTransFast(data []float64, inverse bool) (err error) {
+
+	// check
+	nn := len(data)
+	if nn%2 != 0 {
+		return chk.Err("the length of data must be even (real and imaginary pairs). len(data) = %d is invalid", nn)
+	}
+	n := nn / 2
+	if !utl.IsPowerOfTwo(n) {
+		return chk.Err("the number of complex values N must be power of 2. N = %d is invalid", n)
+	}
+	if bits.TrailingZeros(uint(n))%2 != 0 {
+		return FourierTransLL(data, inverse)
+	}
+	fourierRadix4(data, n, inverse)
+	return
+}
+
+// fourierRadix4 computes the FFT of n complex values using the radix-4 algorithm
+//   NOTE: n must be a power of 4
+func fourierRadix4(data []float64, n int, inverse bool) {
+
+	// digit reversal section (base 4)
+	ndigits := bits.TrailingZeros(uint(n)) / 2
+	for i := 0; i < n; i++ {
+		j, k := 0, i
+		for d := 0; d < ndigits; d++ {
+			j = j<<2 | k&3
+			k >>= 2
+		}
+		if j > i {
+			data[2*j], data[2*i] = data[2*i], data[2*j]
+			data[2*j+1], data[2*i+1] = data[2*i+1], data[2*j+1]
+		}
+	}
+
+	// butterflies
+	//   with a = x[k], b = w¹⋅x[k+q], c = w²⋅x[k+2q] and d = w³⋅x[k+3q]:
+	//     X[k]    = (a + c) +     (b + d)
+	//     X[k+q]  = (a - c) + σ i (b - d)
+	//     X[k+2q] = (a + c) -     (b + d)
+	//     X[k+3q] = (a - c) - σ i (b - d)
+	//   where σ = -1 (forward) or +1 (inverse)
+	sign := -1.0
+	if inverse {
+		sign = 1.0
+	}
+	var wtemp, w1r, w1i, w2r, w2i, w3r, w3i, wpr, wpi, θ float64
+	var ar, ai, br, bi, cr, ci, dr, di, t0r, t0i, t1r, t1i, t2r, t2i, t3r, t3i float64
+	var i0, i1, i2, i3 int
+	for q := 1; q < n; q <<= 2 {
+		istep := q << 2
+		θ = sign * 2.0 * math.Pi / float64(istep)
+		wtemp = math.Sin(0.5 * θ)
+		wpr = -2.0 * wtemp * wtemp
+		wpi = math.Sin(θ)
+		w1r, w1i = 1.0, 0.0
+		for k := 0; k < q; k++ {
+			w2r, w2i = w1r*w1r-w1i*w1i, 2.0*w1r*w1i
+			w3r, w3i = w2r*w1r-w2i*w1i, w2r*w1i+w2i*w1r
+			for i := k; i < n; i += istep {
+				i0, i1, i2, i3 = 2*i, 2*(i+q), 2*(i+2*q), 2*(i+3*q)
+				ar, ai = data[i0], data[i0+1]
+				br = w1r*data[i1] - w1i*data[i1+1]
+				bi = w1r*data[i1+1] + w1i*data[i1]
+				cr = w2r*data[i2] - w2i*data[i2+1]
+				ci = w2r*data[i2+1] + w2i*data[i2]
+				dr = w3r*data[i3] - w3i*data[i3+1]
+				di = w3r*data[i3+1] + w3i*data[i3]
+				t0r, t0i = ar+cr, ai+ci
+				t1r, t1i = ar-cr, ai-ci
+				t2r, t2i = br+dr, bi+di
+				t3r, t3i = br-dr, bi-di
+				data[i0], data[i0+1] = t0r+t2r, t0i+t2i
+				data[i2], data[i2+1] = t0r-t2r, t0i-t2i
+				data[i1], data[i1+1] = t1r-sign*t3i, t1i+sign*t3r
+				data[i3], data[i3+1] = t1r+sign*t3i, t1i-sign*t3r
+			}
+			wtemp = w1r
+			w1r = w1r*wpr - w1i*wpi + w1r // trigonometric recurrence
+			w1i = w1i*wpr + wtemp*wpi + w1i
+		}
+	}
+
+	// normalise
+	if inverse {
+		den := float64(n)
+		for i := 0; i < 2*n; i++ {
+			data[i] /= den
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// FourierTransSplitRadix computes the discrete Fourier transform (DFT) in 1D using the
+// split-radix FFT algorithm; see FourierTransLL
+//
+//   Input:
+//     data -- real array of length 2*N with the complex values stored as pairs:
+//             data = [ real(x[0]), imag(x[0]), real(x[1]), imag(x[1]), ... ]
+//     inverse -- computes the inverse transform instead
+//
+//   Output:
+//     data -- is replaced by its (inverse) discrete Fourier transform
+//
+//   The DFT of size N is split into one DFT of size N/2 (even outputs) and two DFTs of size N/4
+//   (outputs 4k+1 and 4k+3) by means of the "L-shaped" butterfly of [1] (decimation in frequency;
+//   σ = -1 for forward; +1 for inverse):
+//
+//     u[j]     = x[j] + x[j+N/2]                                      j = 0 ... N/2-1
+//     z[j]     = ((x[j] - x[j+N/2]) + σ i (x[j+N/4] - x[j+3N/4])) ⋅ wʲ    j = 0 ... N/4-1
+//     z'[j]    = ((x[j] - x[j+N/2]) - σ i (x[j+N/4] - x[j+3N/4])) ⋅ w³ʲ   with w = exp(σ i 2 π / N)
+//
+//   where the DFTs of u, z and z' give X[2k], X[4k+1] and X[4k+3], respectively
+//
+//   NOTE: (1) N must be an integer power of 2
+//         (2) the computations are carried out in place, followed by the bit reversal
+//             permutation; no memory is allocated and the twiddle factors are computed with the
+//             same trigonometric recurrence as in FourierTransLL
+//         (3) the results are the same as the ones computed by FourierTransLL, apart from
+//             round-off errors
+//
+//   Reference:
+//   [1] Sorensen HV, Heideman MT, Burrus CS (1986) On computing the split-radix FFT. IEEE
+//       Transactions on Acoustics, Speech, and Signal Processing, 34(1):152-156
+//
+func FourierTransSplitRadix(data []float64, inverse bool) (err error) {
+
+	// check
+	nn := len(data)
+	if nn%2 != 0 {
+		return chk.Err("the length of data must be even (real and imaginary pairs). len(data) = %d is invalid", nn)
+	}
+	n := nn / 2
+	if !utl.IsPowerOfTwo(n) {
+		return chk.Err("the number of complex values N must be power of 2. N = %d is invalid", n)
+	}
+
+	// L-shaped butterflies
+	sign := -1.0
+	if inverse {
+		sign = 1.0
+	}
+	var wtemp, wpr, wpi, w1r, w1i, w3r, w3i, θ float64
+	var r1, r2, s1, s2, zr, zi, yr, yi float64
+	var i0, k0, k1, k2, k3 int
+	for n2 := n; n2 > 2; n2 >>= 1 {
+		n4 := n2 >> 2
+		θ = sign * 2.0 * math.Pi / float64(n2)
+		wtemp = math.Sin(0.5 * θ)
+		wpr = -2.0 * wtemp * wtemp
+		wpi = math.Sin(θ)
+		w1r = 1.0
+		w1i = 0.0
+		for j := 0; j < n4; j++ {
+			w3r = w1r * (w1r*w1r - 3.0*w1i*w1i) // w³ = w ⋅ w²
+			w3i = w1i * (3.0*w1r*w1r - w1i*w1i)
+			for is, id := j, 2*n2; is < n-1; is, id = 2*id-n2+j, 4*id {
+				for i0 = is; i0 < n-1; i0 += id {
+					k0, k1, k2, k3 = 2*i0, 2*(i0+n4), 2*(i0+2*n4), 2*(i0+3*n4)
+					r1, s1 = data[k0]-data[k2], data[k0+1]-data[k2+1]
+					r2, s2 = data[k1]-data[k3], data[k1+1]-data[k3+1]
+					data[k0] += data[k2]
+					data[k0+1] += data[k2+1]
+					data[k1] += data[k3]
+					data[k1+1] += data[k3+1]
+					zr, zi = r1-sign*s2, s1+sign*r2
+					yr, yi = r1+sign*s2, s1-sign*r2
+					data[k2], data[k2+1] = zr*w1r-zi*w1i, zr*w1i+zi*w1r
+					data[k3], data[k3+1] = yr*w3r-yi*w3i, yr*w3i+yi*w3r
+				}
+			}
+			wtemp = w1r
+			w1r = w1r*wpr - w1i*wpi + w1r // trigonometric recurrence
+			w1i = w1i*wpr + wtemp*wpi + w1i
+		}
+	}
+
+	// last stage: butterflies of length 2
+	for is, id := 0, 4; is < n-1; is, id = 2*id-2, 4*id {
+		for k0 = 2 * is; k0 < nn-2; k0 += 2 * id {
+			r1, s1 = data[k0+2], data[k0+3]
+			data[k0+2], data[k0+3] = data[k0]-r1, data[k0+1]-s1
+			data[k0] += r1
+			data[k0+1] += s1
+		}
+	}
+
+	// bit reversal section
+	j := 0
+	for i := 0; i < n; i++ {
+		if j > i {
+			data[2*j], data[2*i] = data[2*i], data[2*j]
+			data[2*j+1], data[2*i+1] = data[2*i+1], data[2*j+1]
+		}
+		m := n >> 1
+		for m >= 1 && j&m != 0 {
+			j ^= m
+			m >>= 1
+		}
+		j |= m
+	}
+
+	// normalise
+	if inverse {
+		den := float64(n)
+		for i := 0; i < nn; i++ {
+			data[i] /= den
+		}
+	}
+	return
+}
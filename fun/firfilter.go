@@ -0,0 +1,67 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// FirLowpass designs a low-pass finite impulse response (FIR) filter by the windowed-sinc method
+//
+//   Input:
+//     numTaps -- number of coefficients; must be odd (type I linear-phase filter)
+//     cutoff  -- normalised cutoff frequency; 0 < cutoff < 1 where 1 corresponds to the Nyquist
+//                frequency fs/2
+//     win     -- window with len(win) = numTaps; e.g. computed by Window or KaiserWindow.
+//                If win is nil, the rectangular window is used
+//
+//   Output:
+//     h -- filter coefficients (impulse response):
+//
+//                                   sin(π⋅c⋅(k-M))
+//            h[k] = C ⋅ win[k] ⋅ c ⋅ ——————————————      with M = (numTaps-1)/2 and c = cutoff
+//                                     π⋅c⋅(k-M)
+//
+//          where C is such that the gain at zero frequency is 1; i.e. Σ h[k] = 1
+//
+//   NOTE: the filter is applied with Convolve; e.g. Convolve(x, h, "same") gives the filtered
+//         signal aligned with x (delay of M samples compensated)
+//
+func FirLowpass(numTaps int, cutoff float64, win []float64) (h []float64, err error) {
+
+	// check
+	if numTaps < 1 || numTaps%2 == 0 {
+		return nil, chk.Err("the number of taps must be positive and odd. numTaps = %d is invalid", numTaps)
+	}
+	if cutoff <= 0 || cutoff >= 1 {
+		return nil, chk.Err("the normalised cutoff frequency must be in (0, 1). cutoff = %g is invalid", cutoff)
+	}
+	if win != nil && len(win) != numTaps {
+		return nil, chk.Err("the length of the window must be equal to numTaps = %d. len(win) = %d is invalid", numTaps, len(win))
+	}
+
+	// windowed sinc
+	h = make([]float64, numTaps)
+	M := (numTaps - 1) / 2
+	sum := 0.0
+	for k := 0; k < numTaps; k++ {
+		h[k] = cutoff * Sinc(math.Pi*cutoff*float64(k-M))
+		if win != nil {
+			h[k] *= win[k]
+		}
+		sum += h[k]
+	}
+
+	// unit gain at zero frequency
+	if sum == 0 {
+		return nil, chk.Err("the sum of the filter coefficients is zero; the window is invalid")
+	}
+	for k := 0; k < numTaps; k++ {
+		h[k] /= sum
+	}
+	return
+}
@@ -0,0 +1,144 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/la"
+)
+
+// FitFourier fits a sum of sinusoids with given (arbitrary) frequencies to data in the
+// least-squares sense
+//
+//                  K-1
+//     y(t) ≈ a0  +  Σ   an[k] ⋅ cos(2π freqs[k] t)  +  bn[k] ⋅ sin(2π freqs[k] t)
+//                  k=0
+//
+//   Input:
+//     t     -- time stamps (may be unevenly spaced)
+//     y     -- data values; len(y) = len(t)
+//     freqs -- K distinct positive frequencies (not necessarily harmonics of a fundamental)
+//
+//   Output:
+//     a0     -- constant term
+//     an, bn -- amplitudes of the cosine and sine terms; len(an) = len(bn) = K
+//
+//   NOTE: (1) the normal equations (Aᵀ A) c = Aᵀ y with 2K+1 unknowns are assembled and solved
+//             by the Cholesky factorisation; thus, len(t) ≥ 2K+1 is required
+//         (2) the amplitude and phase of each sinusoid follow from √(an²+bn²) and atan2(bn, an)
+//         (3) an error is returned if the system is singular; e.g. if the sampling cannot
+//             distinguish the frequencies
+//
+func FitFourier(t, y []float64, freqs []float64) (a0 float64, an, bn []float64, err error) {
+
+	// check
+	n, K := len(t), len(freqs)
+	if len(y) != n {
+		return 0, nil, nil, chk.Err("lengths of t and y must be the same. %d != %d", n, len(y))
+	}
+	for k, f := range freqs {
+		if f <= 0 {
+			return 0, nil, nil, chk.Err("frequencies must be positive. freqs[%d] = %g is invalid", k, f)
+		}
+		for l := 0; l < k; l++ {
+			if freqs[l] == f {
+				return 0, nil, nil, chk.Err("frequencies must be distinct. freqs[%d] = freqs[%d] = %g", l, k, f)
+			}
+		}
+	}
+	nc := 2*K + 1
+	if n < nc {
+		return 0, nil, nil, chk.Err("at least 2K+1 = %d data points are required. %d is invalid", nc, n)
+	}
+
+	// basis functions: [1, cos(ω0 t), sin(ω0 t), cos(ω1 t), sin(ω1 t), ...]
+	φ := make([]float64, nc)
+	basis := func(ti float64) {
+		φ[0] = 1
+		for k, f := range freqs {
+			φ[1+2*k+1], φ[1+2*k] = math.Sincos(2 * math.Pi * f * ti)
+		}
+	}
+
+	// normal equations
+	AtA := la.NewMatrix(nc, nc)
+	Aty := la.NewVector(nc)
+	for i := 0; i < n; i++ {
+		basis(t[i])
+		for r := 0; r < nc; r++ {
+			Aty[r] += φ[r] * y[i]
+			for c := r; c < nc; c++ {
+				AtA.Add(r, c, φ[r]*φ[c])
+			}
+		}
+	}
+	for r := 0; r < nc; r++ {
+		for c := 0; c < r; c++ {
+			AtA.Set(r, c, AtA.Get(c, r))
+		}
+	}
+
+	// solve
+	x, err := solveNormalEquations(AtA, Aty)
+	if err != nil {
+		return
+	}
+
+	// results
+	a0 = x[0]
+	an = make([]float64, K)
+	bn = make([]float64, K)
+	for k := 0; k < K; k++ {
+		an[k] = x[1+2*k]
+		bn[k] = x[1+2*k+1]
+	}
+	return
+}
+
+// solveNormalEquations solves the symmetric positive-definite system M x = b by the Cholesky
+// factorisation M = L Lᵀ. An error is returned if a pivot is not sufficiently positive (relative
+// to the largest diagonal value); i.e. if M is (numerically) singular
+func solveNormalEquations(M *la.Matrix, b la.Vector) (x la.Vector, err error) {
+	n := len(b)
+	dmax := 0.0
+	for i := 0; i < n; i++ {
+		dmax = math.Max(dmax, M.Get(i, i))
+	}
+	L := la.NewMatrix(n, n)
+	for j := 0; j < n; j++ {
+		for i := j; i < n; i++ {
+			sum := M.Get(i, j)
+			for k := 0; k < j; k++ {
+				sum -= L.Get(i, k) * L.Get(j, k)
+			}
+			if i == j {
+				if !(sum > 1e-12*dmax) {
+					return nil, chk.Err("the normal equations are singular; the frequencies cannot be resolved with the given time stamps")
+				}
+				L.Set(j, j, math.Sqrt(sum))
+			} else {
+				L.Set(i, j, sum/L.Get(j, j))
+			}
+		}
+	}
+	x = la.NewVector(n)
+	for i := 0; i < n; i++ { // L z = b
+		sum := b[i]
+		for k := 0; k < i; k++ {
+			sum -= L.Get(i, k) * x[k]
+		}
+		x[i] = sum / L.Get(i, i)
+	}
+	for i := n - 1; i >= 0; i-- { // Lᵀ x = z
+		sum := x[i]
+		for k := i + 1; k < n; k++ {
+			sum -= L.Get(k, i) * x[k]
+		}
+		x[i] = sum / L.Get(i, i)
+	}
+	return
+}
@@ -0,0 +1,73 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// FourierSeries computes the coefficients of the real trigonometric Fourier series of a periodic
+// function from N equally spaced samples over one period T; i.e. x[j] = f(j⋅T/N)
+//
+//                   N/2
+//     f(t) ≈ a0  +   Σ   an[k-1] ⋅ cos(2π k t / T)  +  bn[k-1] ⋅ sin(2π k t / T)
+//                   k=1
+//
+//   Output:
+//     a0     -- mean value of the samples: a0 = X[0] / N
+//     an, bn -- coefficients of the harmonics k = 1 ... N/2 stored at index k-1:
+//                 an[k-1] =  (2/N) ⋅ Re(X[k])
+//                 bn[k-1] = -(2/N) ⋅ Im(X[k])
+//               where X is the DFT of x computed by RealFourierTrans
+//
+//   NOTE: (1) N = len(x) must be an integer power of 2 and at least 2
+//         (2) the Nyquist harmonic k = N/2 uses the factor 1/N instead of 2/N, since X[N/2]
+//             appears only once in the DFT; also bn[N/2-1] = 0
+//         (3) a0 is the mean value and not the "a0/2" of some texts
+//
+func FourierSeries(x []float64) (a0 float64, an, bn []float64, err error) {
+	N := len(x)
+	if N < 2 {
+		return 0, nil, nil, chk.Err("at least 2 samples are required. N = %d is invalid", N)
+	}
+	X, err := RealFourierTrans(x)
+	if err != nil {
+		return
+	}
+	M := N / 2
+	a0 = real(X[0]) / float64(N)
+	an = make([]float64, M)
+	bn = make([]float64, M)
+	s := 2 / float64(N)
+	for k := 1; k <= M; k++ {
+		an[k-1] = s * real(X[k])
+		bn[k-1] = -s * imag(X[k])
+	}
+	an[M-1] /= 2
+	bn[M-1] = 0
+	return
+}
+
+// FourierSeriesEval evaluates the trigonometric Fourier series at t (see FourierSeries)
+//
+//   Input:
+//     a0, an, bn -- coefficients; an[k-1] and bn[k-1] correspond to the harmonic k
+//     t          -- time (or coordinate) where the series is evaluated
+//     T          -- period
+//
+func FourierSeriesEval(a0 float64, an, bn []float64, t float64, T float64) (res float64) {
+	if len(an) != len(bn) {
+		chk.Panic("lengths of an and bn must be the same. %d != %d\n", len(an), len(bn))
+	}
+	res = a0
+	ω := 2 * math.Pi * t / T
+	for k := 1; k <= len(an); k++ {
+		s, c := math.Sincos(float64(k) * ω)
+		res += an[k-1]*c + bn[k-1]*s
+	}
+	return
+}
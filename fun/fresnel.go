@@ -0,0 +1,101 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Fresnel computes the Fresnel sine and cosine integrals S(x) and C(x)
+//
+//   Computes:
+//              x                              x
+//     S(x) =  ∫ sin(π t² / 2) dt      C(x) =  ∫ cos(π t² / 2) dt
+//             0                               0
+//
+//   NOTE: (1) for |x| ≤ 1.5, the power series are used; otherwise, the complex continued fraction
+//             for the complementary error function is evaluated by the modified Lentz's method [1]
+//         (2) S and C are odd functions and S(±∞) = C(±∞) = ±1/2
+//         (3) the parametric curve (C(t), S(t)) is the Cornu (Euler) spiral
+//
+//   Reference:
+//   [1] Press WH, Teukolsky SA, Vetterling WT, Fnannery BP (2007) Numerical Recipes: The Art of
+//       Scientific Computing. Third Edition. Cambridge University Press. 1235p.
+//
+func Fresnel(x float64) (s, c float64) {
+
+	// constants
+	const eps = 1e-16
+	const tiny = 1e-300
+	ax := math.Abs(x)
+
+	// compute for |x|
+	switch {
+	case math.IsInf(x, 0):
+		s, c = 0.5, 0.5
+
+	case ax < 1e-150:
+		s, c = 0, ax
+
+	// power series: the terms of S and C alternate
+	case ax <= 1.5:
+		sum, sums, sumc := 0.0, 0.0, ax
+		sign := 1.0
+		fact := 0.5 * math.Pi * ax * ax
+		odd := true
+		term := ax
+		n := 3.0
+		for k := 1; k < 100; k++ {
+			term *= fact / float64(k)
+			sum += sign * term / n
+			test := math.Abs(sum) * eps
+			if odd {
+				sign = -sign
+				sums = sum
+				sum = sumc
+			} else {
+				sumc = sum
+				sum = sums
+			}
+			if term < test {
+				break
+			}
+			odd = !odd
+			n += 2
+		}
+		s, c = sums, sumc
+
+	// continued fraction
+	default:
+		pix2 := math.Pi * ax * ax
+		b := complex(1, -pix2)
+		cc := complex(1/tiny, 0)
+		d := 1 / b
+		h := d
+		n := -1.0
+		for k := 2; k < 100; k++ {
+			n += 2
+			a := complex(-n*(n+1), 0)
+			b += 4
+			d = 1 / (a*d + b)
+			cc = b + a/cc
+			del := cc * d
+			h *= del
+			if math.Abs(real(del)-1)+math.Abs(imag(del)) < eps {
+				break
+			}
+		}
+		h *= complex(ax, -ax)
+		cs := complex(0.5, 0.5) * (1 - cmplx.Exp(complex(0, 0.5*pix2))*h)
+		s, c = imag(cs), real(cs)
+	}
+
+	// odd functions
+	if x < 0 {
+		s, c = -s, -c
+	}
+	return
+}
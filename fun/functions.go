@@ -74,6 +74,97 @@ func Beta(a, b float64) float64 {
 	return float64(sgnla*sgnlb*sgnlc) * math.Exp(la+lb-lc)
 }
 
+// BetaInc computes the regularised incomplete beta function Ix(a, b)
+//
+//   Computes:
+//                       1       x
+//     Ix(a, b)  =   ————————   ∫  tᵃ⁻¹ (1-t)ᵇ⁻¹ dt        with a > 0, b > 0 and 0 ≤ x ≤ 1
+//                    B(a, b)   0
+//
+//   NOTE: (1) the continued fraction of [1] is used for x < (a+1)/(a+b+2); otherwise, the
+//             symmetry Ix(a, b) = 1 - I₁₋ₓ(b, a) is used, where the continued fraction
+//             converges rapidly
+//         (2) I₀(a, b) = 0 and I₁(a, b) = 1; the result is NaN for invalid arguments
+//         (3) Ix(a, b) is the CDF of the beta distribution and gives the CDFs of the Student-t
+//             and F distributions
+//
+//   Reference:
+//   [1] Press WH, Teukolsky SA, Vetterling WT, Fnannery BP (2007) Numerical Recipes: The Art of
+//       Scientific Computing. Third Edition. Cambridge University Press. 1235p.
+//
+func BetaInc(x, a, b float64) float64 {
+	switch {
+	case math.IsNaN(x) || math.IsNaN(a) || math.IsNaN(b) || a <= 0 || b <= 0 || x < 0 || x > 1:
+		return math.NaN()
+	case x == 0:
+		return 0
+	case x == 1:
+		return 1
+	}
+	la, _ := LnGamma(a)
+	lb, _ := LnGamma(b)
+	lc, _ := LnGamma(a + b)
+	bt := math.Exp(lc - la - lb + a*math.Log(x) + b*math.Log1p(-x))
+	if x < (a+1)/(a+b+2) {
+		return bt * betaContFrac(x, a, b) / a
+	}
+	return 1 - bt*betaContFrac(1-x, b, a)/b
+}
+
+// betaContFrac evaluates the continued fraction for the incomplete beta function by means of
+// the modified Lentz's method
+//
+//                            1   d₁  d₂              (a+m)(a+b+m) x               m(b-m) x
+//   Ix(a, b) ∝ —— ——— ———  ...   d₂ₘ₊₁ = - ————————————————————    d₂ₘ = ———————————————————
+//                1+  1+  1+                  (a+2m)(a+2m+1)              (a+2m-1)(a+2m)
+//
+func betaContFrac(x, a, b float64) float64 {
+	const tiny = 1e-300
+	qab, qap, qam := a+b, a+1, a-1
+	c := 1.0
+	d := 1 - qab*x/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+	for m := 1; m < 10000; m++ {
+		M := float64(m)
+		m2 := 2 * M
+
+		// even step
+		aa := M * (b - M) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+
+		// odd step
+		aa = -(a + M) * (qab + M) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < 1e-16 {
+			break
+		}
+	}
+	return h
+}
+
 // Binomial comptues the binomial coefficient (n k)^T
 func Binomial(n, k int) float64 {
 	if n < 0 || k < 0 || k > n {
@@ -0,0 +1,389 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import "math"
+
+// Gamma computes the gamma function Γ(x) using the Lanczos approximation
+//
+//   NOTE: (1) for x < 0.5, the reflection formula Γ(x) Γ(1-x) = π / sin(πx) is used
+//         (2) for positive integers x = n ≤ 23, the exact value (n-1)! is returned
+//
+//   Special cases:
+//     Γ(0)   = +Inf
+//     Γ(-n)  = NaN for integers n > 0 (poles)
+//     Γ(x)   = +Inf for x > 171.62 (overflow)
+//     Γ(NaN) = NaN
+//
+//   Reference:
+//   [1] Press WH, Teukolsky SA, Vetterling WT, Fnannery BP (2007) Numerical Recipes: The Art of
+//       Scientific Computing. Third Edition. Cambridge University Press. 1235p.
+//
+func Gamma(x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case x == 0:
+		return math.Inf(+1)
+	case x < 0 && x == math.Floor(x):
+		return math.NaN()
+	case x > 0 && x <= 23 && x == math.Floor(x):
+		return Factorial22(int(x) - 1)
+	case x > 171.7:
+		return math.Inf(+1)
+	}
+	if x < 0.5 {
+		return math.Pi / (sinPi(x) * Gamma(1-x))
+	}
+	t := x + lanczosG - 0.5
+	p := math.Pow(t, 0.5*(x-0.5)) // split the power to avoid premature overflow
+	return math.Sqrt(2*math.Pi) * p * (p * math.Exp(-t)) * lanczosSum(x)
+}
+
+// LnGamma computes the natural logarithm of the absolute value of the gamma function and the
+// sign of Γ(x); i.e. Γ(x) = sign ⋅ exp(val)
+//
+//   NOTE: for x < 0.5, the reflection formula Γ(x) Γ(1-x) = π / sin(πx) is used
+//
+//   Special cases:
+//     LnGamma(0)  = (+Inf, 1)
+//     LnGamma(-n) = (+Inf, 1) for integers n > 0 (poles)
+//     LnGamma(NaN) = (NaN, 1)
+//
+func LnGamma(x float64) (val float64, sign int) {
+	sign = 1
+	switch {
+	case math.IsNaN(x):
+		return math.NaN(), sign
+	case x <= 0 && x == math.Floor(x):
+		return math.Inf(+1), sign
+	case math.IsInf(x, +1):
+		return math.Inf(+1), sign
+	}
+	if x < 0.5 {
+		s := sinPi(x)
+		if s < 0 {
+			sign = -1
+		}
+		v, _ := LnGamma(1 - x)
+		return math.Log(math.Pi) - math.Log(math.Abs(s)) - v, sign
+	}
+	t := x + lanczosG - 0.5
+	return 0.5*math.Log(2*math.Pi) + (x-0.5)*math.Log(t) - t + math.Log(lanczosSum(x)), sign
+}
+
+// lanczosG is the parameter g of the Lanczos approximation
+const lanczosG = 7.0
+
+// lanczosCoef holds the coefficients of the Lanczos approximation with g = 7 and n = 9
+var lanczosCoef = []float64{
+	0.99999999999980993,
+	676.5203681218851,
+	-1259.1392167224028,
+	771.32342877765313,
+	-176.61502916214059,
+	12.507343278686905,
+	-0.13857109526572012,
+	9.9843695780195716e-6,
+	1.5056327351493116e-7,
+}
+
+// lanczosSum computes the series A(x) = c0 + Σ c[i] / (x + i - 1) of the Lanczos approximation
+func lanczosSum(x float64) (sum float64) {
+	sum = lanczosCoef[0]
+	for i := 1; i < len(lanczosCoef); i++ {
+		sum += lanczosCoef[i] / (x + float64(i) - 1)
+	}
+	return
+}
+
+// sinPi computes sin(π x) with the argument reduced to [-1, 1] to preserve accuracy
+func sinPi(x float64) float64 {
+	r := math.Mod(x, 2)
+	return math.Sin(math.Pi * r)
+}
+
+// GammaP computes the regularised lower incomplete gamma function P(a, x) = γ(a, x) / Γ(a)
+//
+//   Computes:
+//                     1     x
+//     P(a, x)  =   ————    ∫  exp(-t) tᵃ⁻¹ dt        with a > 0 and x ≥ 0
+//                   Γ(a)   0
+//
+//   NOTE: (1) the series expansion is used for x < a+1 and the continued fraction for Q(a, x)
+//             otherwise, as in [1]
+//         (2) P(a, 0) = 0 and P(a, +Inf) = 1; the result is NaN if a ≤ 0 or x < 0
+//         (3) P(k/2, x/2) is the CDF of the chi-squared distribution with k degrees of freedom
+//
+//   Reference:
+//   [1] Press WH, Teukolsky SA, Vetterling WT, Fnannery BP (2007) Numerical Recipes: The Art of
+//       Scientific Computing. Third Edition. Cambridge University Press. 1235p.
+//
+func GammaP(a, x float64) float64 {
+	switch {
+	case math.IsNaN(a) || math.IsNaN(x) || a <= 0 || x < 0:
+		return math.NaN()
+	case x == 0:
+		return 0
+	case math.IsInf(x, +1):
+		return 1
+	}
+	if x < a+1 {
+		return gammaSeries(a, x)
+	}
+	return 1 - gammaContFrac(a, x)
+}
+
+// GammaQ computes the regularised upper incomplete gamma function Q(a, x) = 1 - P(a, x); i.e.
+//
+//                     1     ∞
+//     Q(a, x)  =   ————    ∫  exp(-t) tᵃ⁻¹ dt        with a > 0 and x ≥ 0
+//                   Γ(a)   x
+//
+//   NOTE: Q(a, x) is computed directly (not as 1 - P) for x ≥ a+1 to avoid cancellation. See GammaP
+//
+func GammaQ(a, x float64) float64 {
+	switch {
+	case math.IsNaN(a) || math.IsNaN(x) || a <= 0 || x < 0:
+		return math.NaN()
+	case x == 0:
+		return 1
+	case math.IsInf(x, +1):
+		return 0
+	}
+	if x < a+1 {
+		return 1 - gammaSeries(a, x)
+	}
+	return gammaContFrac(a, x)
+}
+
+// GammaIncLower computes the (non-regularised) lower incomplete gamma function
+// γ(a, x) = ∫₀ˣ exp(-t) tᵃ⁻¹ dt = Γ(a) P(a, x)
+func GammaIncLower(a, x float64) float64 {
+	return Gamma(a) * GammaP(a, x)
+}
+
+// GammaIncUpper computes the (non-regularised) upper incomplete gamma function
+// Γ(a, x) = ∫ₓ^∞ exp(-t) tᵃ⁻¹ dt = Γ(a) Q(a, x)
+func GammaIncUpper(a, x float64) float64 {
+	return Gamma(a) * GammaQ(a, x)
+}
+
+// gammaSeries computes P(a, x) by means of the series
+//
+//                              ∞          xⁿ
+//   P(a, x) = exp(-x) xᵃ / Γ(a) Σ  ——————————————————
+//                             n=0  a (a+1) ... (a+n)
+//
+func gammaSeries(a, x float64) float64 {
+	ap := a
+	del := 1.0 / a
+	sum := del
+	for n := 0; n < 100000; n++ {
+		ap++
+		del *= x / ap
+		sum += del
+		if math.Abs(del) < math.Abs(sum)*1e-16 {
+			break
+		}
+	}
+	lg, _ := LnGamma(a)
+	return sum * math.Exp(-x+a*math.Log(x)-lg)
+}
+
+// gammaContFrac computes Q(a, x) by means of the continued fraction (modified Lentz's method)
+//
+//                                   1    1⋅(1-a)     2⋅(2-a)
+//   Q(a, x) = exp(-x) xᵃ / Γ(a)  ————— ——————————— ——————————— ...
+//                                x+1-a-  x+3-a-      x+5-a-
+//
+func gammaContFrac(a, x float64) float64 {
+	const tiny = 1e-300
+	b := x + 1 - a
+	c := 1.0 / tiny
+	d := 1.0 / b
+	h := d
+	for i := 1; i < 100000; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1.0 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < 1e-16 {
+			break
+		}
+	}
+	lg, _ := LnGamma(a)
+	return math.Exp(-x+a*math.Log(x)-lg) * h
+}
+
+// Digamma computes the digamma function ψ(x) = d ln Γ(x) / dx = Γ'(x) / Γ(x)
+//
+//   NOTE: (1) for x < 10, the recurrence ψ(x) = ψ(x+1) - 1/x is used to shift the argument;
+//             then, the asymptotic series below is evaluated
+//
+//               ψ(x) ≈ ln(x) - 1/(2x) - Σ B[2k] / (2k x²ᵏ)     (B[n] = Bernoulli numbers)
+//
+//         (2) for x < 0, the reflection formula ψ(1-x) - ψ(x) = π cot(πx) is used
+//
+//   Special cases:
+//     ψ(-n)   = NaN for integers n ≥ 0 (poles)
+//     ψ(+Inf) = +Inf
+//     ψ(NaN)  = NaN
+//
+func Digamma(x float64) float64 {
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, -1):
+		return math.NaN()
+	case x <= 0 && x == math.Floor(x):
+		return math.NaN()
+	case math.IsInf(x, +1):
+		return x
+	}
+	if x < 0 {
+		return Digamma(1-x) - math.Pi*cosPi(x)/sinPi(x)
+	}
+	res := 0.0
+	for x < 10 {
+		res -= 1 / x
+		x++
+	}
+	r := 1 / (x * x)
+	res += math.Log(x) - 0.5/x - r*(1.0/12-r*(1.0/120-r*(1.0/252-r*(1.0/240-r*(1.0/132-r*(691.0/32760-r/12))))))
+	return res
+}
+
+// Trigamma computes the trigamma function ψ₁(x) = d ψ(x) / dx; see Digamma
+//
+//   NOTE: (1) for x < 10, the recurrence ψ₁(x) = ψ₁(x+1) + 1/x² is used to shift the argument;
+//             then, the asymptotic series below is evaluated
+//
+//               ψ₁(x) ≈ 1/x + 1/(2x²) + Σ B[2k] / x²ᵏ⁺¹
+//
+//         (2) for x < 0, the reflection formula ψ₁(1-x) + ψ₁(x) = π² / sin²(πx) is used
+//
+//   Special cases:
+//     ψ₁(-n)   = NaN for integers n ≥ 0 (poles)
+//     ψ₁(+Inf) = 0
+//     ψ₁(NaN)  = NaN
+//
+func Trigamma(x float64) float64 {
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, -1):
+		return math.NaN()
+	case x <= 0 && x == math.Floor(x):
+		return math.NaN()
+	case math.IsInf(x, +1):
+		return 0
+	}
+	if x < 0 {
+		s := sinPi(x)
+		return -Trigamma(1-x) + math.Pi*math.Pi/(s*s)
+	}
+	res := 0.0
+	for x < 10 {
+		res += 1 / (x * x)
+		x++
+	}
+	r := 1 / (x * x)
+	res += 1/x + 0.5*r + r/x*(1.0/6-r*(1.0/30-r*(1.0/42-r*(1.0/30-r*(5.0/66-r*(691.0/2730-r*7.0/6))))))
+	return res
+}
+
+// cosPi computes cos(π x) with the argument reduced to [-1, 1] to preserve accuracy
+func cosPi(x float64) float64 {
+	r := math.Mod(x, 2)
+	return math.Cos(math.Pi * r)
+}
+
+// Zeta computes the Riemann zeta function for real s ≠ 1
+//
+//                ∞    1
+//     ζ(s)  =    Σ   ——        for s > 1 (and by analytic continuation otherwise)
+//               n=1   nˢ
+//
+//   NOTE: (1) for s ≥ 0, the Euler-Maclaurin summation with N = 10 is used:
+//
+//                     N-1            N¹⁻ˢ     N⁻ˢ     p   B[2k]   s (s+1) ⋯ (s+2k-2)
+//             ζ(s) ≈   Σ  n⁻ˢ   +   ————  +  ———  +   Σ  ————— ⋅ ——————————————————
+//                     n=1           s - 1      2     k=1 (2k)!        N^(s+2k-1)
+//
+//             which also holds in the critical strip 0 < s < 1; e.g. ζ(0) = -1/2
+//         (2) for s < 0, the functional equation ζ(s) = 2ˢ πˢ⁻¹ sin(πs/2) Γ(1-s) ζ(1-s) is used;
+//             hence ζ(-2n) = 0 for integers n > 0 (trivial zeros)
+//
+//   Special cases:
+//     ζ(1)    = +Inf (pole)
+//     ζ(+Inf) = 1
+//     ζ(-Inf) = NaN
+//     ζ(NaN)  = NaN
+//
+func Zeta(s float64) float64 {
+	switch {
+	case math.IsNaN(s) || math.IsInf(s, -1):
+		return math.NaN()
+	case s == 1:
+		return math.Inf(+1)
+	case math.IsInf(s, +1):
+		return 1
+	case s < 0 && math.Mod(s, 2) == 0:
+		return 0
+	}
+	if s < 0 {
+		z := Zeta(1 - s)
+		if 1-s < 170 {
+			return math.Pow(2*math.Pi, s) / math.Pi * sinPi(s/2) * Gamma(1-s) * z
+		}
+		lg, _ := LnGamma(1 - s) // Γ(1-s) overflows
+		return sinPi(s/2) * z * math.Exp(s*math.Log(2*math.Pi)-math.Log(math.Pi)+lg)
+	}
+	return zetaEulerMaclaurin(s)
+}
+
+// zetaBernoulli holds the coefficients B[2k] / (2k)! for k = 1 ... 13 (B[n] = Bernoulli numbers)
+var zetaBernoulli = []float64{
+	8.33333333333333287e-02,
+	-1.38888888888888894e-03,
+	3.30687830687830710e-05,
+	-8.26719576719576754e-07,
+	2.08767569878681002e-08,
+	-5.28419013868749322e-10,
+	1.33825365306846789e-11,
+	-3.38968029632258272e-13,
+	8.58606205627784517e-15,
+	-2.17486869855806192e-16,
+	5.50900282836022953e-18,
+	-1.39544646858125223e-19,
+	3.53470703962946728e-21,
+}
+
+// zetaEulerMaclaurin computes ζ(s) for s ≥ 0 (s ≠ 1) by means of the Euler-Maclaurin summation
+func zetaEulerMaclaurin(s float64) float64 {
+	const N = 10.0
+	sum := 0.0
+	for n := N - 1; n >= 1; n-- { // smallest terms first
+		sum += math.Pow(n, -s)
+	}
+	Ns := math.Pow(N, -s)
+	sum += N*Ns/(s-1) + 0.5*Ns
+	p := s * Ns / N // s (s+1) ⋯ (s+2k-2) / N^(s+2k-1)
+	for k := 1; k <= len(zetaBernoulli); k++ {
+		term := zetaBernoulli[k-1] * p
+		sum += term
+		if math.Abs(term) < 1e-17*math.Abs(sum) {
+			break
+		}
+		p *= (s + float64(2*k-1)) * (s + float64(2*k)) / (N * N)
+	}
+	return sum
+}
@@ -0,0 +1,65 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import "math"
+
+// Goertzel computes the k-th coefficient of the discrete Fourier transform (DFT) of x by means
+// of the Goertzel algorithm
+//
+//   x -- real array of length N (any N > 0)
+//   k -- index of bin; e.g. 0 ≤ k < N
+//
+//   Computes:
+//                N-1         -i 2 π j k / N
+//         X[k] =  Σ  x[j] ⋅ e
+//                j=0
+//
+//   NOTE: the cost is O(N) per bin; thus, Goertzel is faster than a full FFT when only a few
+//         bins are required; e.g. for tones detection
+//
+func Goertzel(x []float64, k int) complex128 {
+	return goertzel(x, 2.0*math.Pi*float64(k)/float64(len(x)))
+}
+
+// GoertzelFreq computes the discrete-time Fourier transform of x at the (arbitrary) frequency
+// freq by means of the Goertzel algorithm
+//
+//   x    -- real array of length N
+//   freq -- target frequency; needs not correspond to an integer bin
+//   fs   -- sampling frequency (same units as freq)
+//
+//   Computes:
+//                 N-1         -i 2 π j freq / fs
+//         X(f) =   Σ  x[j] ⋅ e
+//                 j=0
+//
+//   NOTE: with freq = k ⋅ fs / N, the result equals Goertzel(x, k)
+//
+func GoertzelFreq(x []float64, freq, fs float64) complex128 {
+	return goertzel(x, 2.0*math.Pi*freq/fs)
+}
+
+// goertzel implements the Goertzel recurrence for the normalised angular frequency ω
+//
+//   s[j] = x[j] + 2 cos(ω) s[j-1] - s[j-2]    with s[-1] = s[-2] = 0
+//
+//   and the result is X(ω) = exp(-i ω N) ⋅ (s[N] - exp(-i ω) s[N-1]) with x[N] = 0
+//
+func goertzel(x []float64, ω float64) complex128 {
+	N := len(x)
+	if N == 0 {
+		return 0
+	}
+	coef := 2.0 * math.Cos(ω)
+	var s, s1, s2 float64
+	for j := 0; j < N; j++ {
+		s = x[j] + coef*s1 - s2
+		s2, s1 = s1, s
+	}
+	s = coef*s1 - s2 // extra step with x[N] = 0
+	y := complex(s, 0) - ExpMix(ω)*complex(s1, 0)
+	return ExpMix(ω*float64(N)) * y
+}
@@ -0,0 +1,202 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"sort"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// Grid2d holds values z[i][j] = f(xs[i], ys[j]) on a rectilinear grid and implements bilinear and
+// bicubic interpolation
+//
+//   The bicubic interpolant is the tensor-product cubic Hermite polynomial in each cell; the
+//   derivatives ∂z/∂x, ∂z/∂y and ∂²z/∂x∂y at the nodes are estimated by second-order finite
+//   differences (one-sided at the boundaries) when the grid is created. Thus the bicubic
+//   interpolant is C¹ and reproduces quadratic functions exactly.
+//
+type Grid2d struct {
+
+	// options
+	OutOfDomain string // policy for points outside the grid: "clamp" [default] or "error" (panic)
+
+	// input
+	X []float64   // x-coordinates (strictly increasing)
+	Y []float64   // y-coordinates (strictly increasing)
+	Z [][]float64 // values: Z[i][j] = f(X[i], Y[j])
+
+	// derived
+	zx  [][]float64 // ∂z/∂x at nodes
+	zy  [][]float64 // ∂z/∂y at nodes
+	zxy [][]float64 // ∂²z/∂x∂y at nodes
+}
+
+// NewGrid2d creates a new Grid2d
+//
+//   Input:
+//     xs -- strictly increasing x-coordinates; len(xs) ≥ 2
+//     ys -- strictly increasing y-coordinates; len(ys) ≥ 2
+//     z  -- values with z[i][j] = f(xs[i], ys[j]); i.e. len(z) = len(xs) and len(z[i]) = len(ys)
+//
+//   NOTE: the input slices are not copied
+//
+func NewGrid2d(xs, ys []float64, z [][]float64) (o *Grid2d, err error) {
+
+	// check
+	if err = checkGridAxis("x", xs); err != nil {
+		return
+	}
+	if err = checkGridAxis("y", ys); err != nil {
+		return
+	}
+	nx, ny := len(xs), len(ys)
+	if len(z) != nx {
+		return nil, chk.Err("the number of rows of z must be equal to len(xs) = %d. %d is invalid", nx, len(z))
+	}
+	for i := 0; i < nx; i++ {
+		if len(z[i]) != ny {
+			return nil, chk.Err("the number of columns of z must be equal to len(ys) = %d. len(z[%d]) = %d is invalid", ny, i, len(z[i]))
+		}
+	}
+
+	// new grid
+	o = &Grid2d{OutOfDomain: "clamp", X: xs, Y: ys, Z: z}
+
+	// derivatives along x (for each j) and along y (for each i)
+	o.zx = make([][]float64, nx)
+	o.zy = make([][]float64, nx)
+	o.zxy = make([][]float64, nx)
+	col := make([]float64, nx)
+	dcol := make([]float64, nx)
+	for i := 0; i < nx; i++ {
+		o.zx[i] = make([]float64, ny)
+		o.zy[i] = make([]float64, ny)
+		o.zxy[i] = make([]float64, ny)
+		gridDeriv(o.zy[i], ys, z[i])
+	}
+	for j := 0; j < ny; j++ {
+		for i := 0; i < nx; i++ {
+			col[i] = z[i][j]
+		}
+		gridDeriv(dcol, xs, col)
+		for i := 0; i < nx; i++ {
+			o.zx[i][j] = dcol[i]
+			col[i] = o.zy[i][j]
+		}
+		gridDeriv(dcol, xs, col)
+		for i := 0; i < nx; i++ {
+			o.zxy[i][j] = dcol[i]
+		}
+	}
+	return
+}
+
+// Bilinear computes the bilinear interpolation at (x, y)
+func (o *Grid2d) Bilinear(x, y float64) float64 {
+	i, t := o.cell(o.X, x, "x")
+	j, u := o.cell(o.Y, y, "y")
+	return (1-t)*(1-u)*o.Z[i][j] + t*(1-u)*o.Z[i+1][j] + (1-t)*u*o.Z[i][j+1] + t*u*o.Z[i+1][j+1]
+}
+
+// Bicubic computes the bicubic (Hermite) interpolation at (x, y)
+func (o *Grid2d) Bicubic(x, y float64) (res float64) {
+	i, t := o.cell(o.X, x, "x")
+	j, u := o.cell(o.Y, y, "y")
+	hx := o.X[i+1] - o.X[i]
+	hy := o.Y[j+1] - o.Y[j]
+	φt, ψt := hermiteBasis(t)
+	φu, ψu := hermiteBasis(u)
+	for a := 0; a < 2; a++ {
+		for b := 0; b < 2; b++ {
+			I, J := i+a, j+b
+			res += φt[a]*φu[b]*o.Z[I][J] +
+				ψt[a]*φu[b]*hx*o.zx[I][J] +
+				φt[a]*ψu[b]*hy*o.zy[I][J] +
+				ψt[a]*ψu[b]*hx*hy*o.zxy[I][J]
+		}
+	}
+	return
+}
+
+// cell returns the index k of the interval [xs[k], xs[k+1]] containing x and the local
+// coordinate t = (x - xs[k]) / (xs[k+1] - xs[k]) ∈ [0, 1], according to the out-of-domain policy
+func (o *Grid2d) cell(xs []float64, x float64, name string) (k int, t float64) {
+	n := len(xs)
+	if x < xs[0] || x > xs[n-1] {
+		switch o.OutOfDomain {
+		case "clamp":
+			if x < xs[0] {
+				return 0, 0
+			}
+			return n - 2, 1
+		case "error":
+			chk.Panic("%s = %g is outside the grid [%g, %g]\n", name, x, xs[0], xs[n-1])
+		default:
+			chk.Panic("out-of-domain policy %q is invalid. Options are \"clamp\" or \"error\"\n", o.OutOfDomain)
+		}
+	}
+	k = sort.SearchFloat64s(xs, x) - 1
+	if k < 0 {
+		k = 0
+	}
+	if k > n-2 {
+		k = n - 2
+	}
+	t = (x - xs[k]) / (xs[k+1] - xs[k])
+	return
+}
+
+// checkGridAxis checks that an axis has at least 2 strictly increasing values
+func checkGridAxis(name string, xs []float64) error {
+	if len(xs) < 2 {
+		return chk.Err("the %s-axis must have at least 2 values. %d is invalid", name, len(xs))
+	}
+	for i := 1; i < len(xs); i++ {
+		if xs[i] <= xs[i-1] {
+			return chk.Err("the %s-axis must be strictly increasing. %s[%d] = %g ≤ %s[%d] = %g", name, name, i, xs[i], name, i-1, xs[i-1])
+		}
+	}
+	return nil
+}
+
+// gridDeriv estimates df/dx at the (non-uniformly spaced) points xs using second-order
+// three-point finite differences; one-sided formulae are used at the ends. With only 2 points,
+// the slope of the segment is used
+func gridDeriv(d, xs, f []float64) {
+	n := len(xs)
+	if n == 2 {
+		d[0] = (f[1] - f[0]) / (xs[1] - xs[0])
+		d[1] = d[0]
+		return
+	}
+	for i := 0; i < n; i++ {
+		k := i // centre of the three-point stencil
+		if i == 0 {
+			k = 1
+		} else if i == n-1 {
+			k = n - 2
+		}
+		x0, x1, x2 := xs[k-1], xs[k], xs[k+1]
+		x := xs[i]
+
+		// derivative of the quadratic Lagrange polynomial through the three points
+		d[i] = f[k-1]*(2*x-x1-x2)/((x0-x1)*(x0-x2)) +
+			f[k]*(2*x-x0-x2)/((x1-x0)*(x1-x2)) +
+			f[k+1]*(2*x-x0-x1)/((x2-x0)*(x2-x1))
+	}
+}
+
+// hermiteBasis returns the cubic Hermite basis functions at t ∈ [0, 1]; φ[0] and φ[1] multiply
+// the values at t=0 and t=1 and ψ[0] and ψ[1] multiply the (scaled) derivatives at t=0 and t=1
+func hermiteBasis(t float64) (φ, ψ [2]float64) {
+	t2 := t * t
+	t3 := t2 * t
+	φ[0] = 2*t3 - 3*t2 + 1
+	φ[1] = -2*t3 + 3*t2
+	ψ[0] = t3 - 2*t2 + t
+	ψ[1] = t3 - t2
+	return
+}
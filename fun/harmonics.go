@@ -0,0 +1,138 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// FundamentalFreq estimates the fundamental frequency (pitch) of x by means of the
+// autocorrelation method
+//
+//   x  -- real signal with M ≥ 4 samples
+//   fs -- sampling frequency (e.g. in Hz)
+//
+//   Output:
+//     f0 -- estimated fundamental frequency
+//
+//   NOTE: (1) the autocorrelation r[ℓ] is computed by AutoCorrelate; the zero-lag peak is
+//             skipped by starting the search after the first zero crossing (or minimum) of r
+//         (2) the search range of lags is [2, M/2]; i.e. fs/(M/2) ≤ f0 ≤ fs/2, thus at least
+//             two periods of the signal are required
+//         (3) the lag of the peak is refined by parabolic interpolation; thus the estimate is
+//             not limited to integer divisors of fs
+//
+func FundamentalFreq(x []float64, fs float64) (f0 float64, err error) {
+
+	// check
+	M := len(x)
+	if M < 4 {
+		return 0, chk.Err("the signal must have at least 4 samples. len(x) = %d is invalid", M)
+	}
+	if fs <= 0 {
+		return 0, chk.Err("the sampling frequency must be positive. fs = %g is invalid", fs)
+	}
+
+	// autocorrelation at non-negative lags
+	c, err := AutoCorrelate(x)
+	if err != nil {
+		return
+	}
+	r := c[M-1:]
+	if r[0] <= 0 {
+		return 0, chk.Err("the autocorrelation of a zero signal cannot be used to estimate the pitch")
+	}
+
+	// skip zero-lag lobe
+	lagMax := M / 2
+	lag := 1
+	for lag < lagMax && r[lag] > 0 && r[lag+1] < r[lag] {
+		lag++
+	}
+
+	// peak
+	imax := lag
+	for ℓ := lag; ℓ <= lagMax; ℓ++ {
+		if r[ℓ] > r[imax] {
+			imax = ℓ
+		}
+	}
+	if imax < 2 || imax >= lagMax || r[imax] <= 0 {
+		return 0, chk.Err("a periodic component could not be found in the range of lags [2, %d]", lagMax)
+	}
+
+	// parabolic interpolation
+	a, b, d := r[imax-1], r[imax], r[imax+1]
+	δ := 0.0
+	if den := a - 2*b + d; den != 0 {
+		δ = 0.5 * (a - d) / den
+	}
+	return fs / (float64(imax) + δ), nil
+}
+
+// THD computes the total harmonic distortion of x
+//
+//   x           -- real signal with N samples; N must be an integer power of 2
+//   fs          -- sampling frequency (e.g. in Hz)
+//   fundamental -- frequency of the fundamental component; 0 < fundamental ≤ fs/2
+//   nHarmonics  -- number of harmonics (2f, 3f, ..., (nHarmonics+1)f) to consider; ≥ 1
+//
+//   Computes:
+//                 ______________________
+//               \/ A[2]² + A[3]² + ...
+//     thd = ——————————————————————————
+//                     A[1]
+//
+//     where A[h] = |X[k]| is the magnitude of the bin k nearest to the frequency h⋅f
+//
+//   NOTE: (1) the harmonics above the Nyquist frequency fs/2 are ignored
+//         (2) for accurate results, the frequencies should coincide with bins; i.e. the
+//             record should contain an integer number of periods; otherwise windowing is
+//             recommended because of the spectral leakage
+//
+func THD(x []float64, fs, fundamental float64, nHarmonics int) (thd float64, err error) {
+
+	// check
+	N := len(x)
+	if fs <= 0 {
+		return 0, chk.Err("the sampling frequency must be positive. fs = %g is invalid", fs)
+	}
+	if fundamental <= 0 || fundamental > fs/2 {
+		return 0, chk.Err("the fundamental frequency must be in (0, fs/2] = (0, %g]. fundamental = %g is invalid", fs/2, fundamental)
+	}
+	if nHarmonics < 1 {
+		return 0, chk.Err("the number of harmonics must be at least 1. nHarmonics = %d is invalid", nHarmonics)
+	}
+
+	// spectrum
+	X, err := RealFourierTrans(x)
+	if err != nil {
+		return
+	}
+	bin := func(f float64) int {
+		return int(math.Round(f * float64(N) / fs))
+	}
+
+	// fundamental
+	A1 := cmplx.Abs(X[bin(fundamental)])
+	if A1 == 0 {
+		return 0, chk.Err("the amplitude of the fundamental component is zero")
+	}
+
+	// harmonics
+	sum := 0.0
+	for h := 2; h <= nHarmonics+1; h++ {
+		f := float64(h) * fundamental
+		if f > fs/2 {
+			break
+		}
+		A := cmplx.Abs(X[bin(f)])
+		sum += A * A
+	}
+	return math.Sqrt(sum) / A1, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import "github.com/cpmech/gosl/chk"
+
+// HermitePhys computes the (physicists') Hermite polynomial Hn(x) by means of the recurrence
+//
+//   H[0] = 1,  H[1] = 2 x  and  H[k+1] = 2 x H[k] - 2 k H[k-1]
+//
+//   NOTE: (1) the polynomials are orthogonal on (-∞, ∞) with weight exp(-x²):
+//             ∫ exp(-x²) Hm Hn dx = √π 2ⁿ n! δmn
+//         (2) n must be non-negative
+//
+func HermitePhys(n int, x float64) float64 {
+	if n < 0 {
+		chk.Panic("the degree n must be non-negative. n = %d is invalid\n", n)
+	}
+	if n == 0 {
+		return 1
+	}
+	hm, h := 1.0, 2*x
+	for k := 1; k < n; k++ {
+		hm, h = h, 2*x*h-2*float64(k)*hm
+	}
+	return h
+}
+
+// Laguerre computes the Laguerre polynomial Ln(x) by means of the recurrence
+//
+//   L[0] = 1,  L[1] = 1 - x  and  (k+1) L[k+1] = (2k + 1 - x) L[k] - k L[k-1]
+//
+//   NOTE: (1) the polynomials are orthonormal on [0, ∞) with weight exp(-x):
+//             ∫ exp(-x) Lm Ln dx = δmn
+//         (2) n must be non-negative
+//
+func Laguerre(n int, x float64) float64 {
+	if n < 0 {
+		chk.Panic("the degree n must be non-negative. n = %d is invalid\n", n)
+	}
+	if n == 0 {
+		return 1
+	}
+	lm, l := 1.0, 1-x
+	for k := 1; k < n; k++ {
+		a := float64(k)
+		lm, l = l, ((2*a+1-x)*l-a*lm)/(a+1)
+	}
+	return l
+}
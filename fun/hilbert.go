@@ -0,0 +1,65 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math/cmplx"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// Hilbert computes the analytic signal of x by means of the discrete Hilbert transform
+//
+//   x -- real signal with N samples; N must be an integer power of 2
+//
+//   Output:
+//     z -- analytic signal: z[k] = x[k] + i⋅H{x}[k] where H is the Hilbert transform
+//
+//   NOTE: the spectrum of x is computed and the negative frequencies are set to zero whereas
+//         the positive frequencies are doubled; DC and Nyquist bins are not modified
+//
+func Hilbert(x []float64) (z []complex128, err error) {
+
+	// check
+	N := len(x)
+	if !utl.IsPowerOfTwo(N) {
+		return nil, chk.Err("the length of x must be power of 2. N = %d is invalid", N)
+	}
+
+	// spectrum of positive frequencies
+	X, err := RealFourierTrans(x)
+	if err != nil {
+		return
+	}
+
+	// zero negative frequencies and double positive ones
+	z = make([]complex128, N)
+	z[0] = X[0]
+	if N == 1 {
+		return
+	}
+	for k := 1; k < N/2; k++ {
+		z[k] = 2 * X[k]
+	}
+	z[N/2] = X[N/2]
+	err = DftInplace(z, true)
+	return
+}
+
+// Envelope computes the instantaneous amplitude (envelope) of x; i.e. |z| where z is the
+// analytic signal computed by Hilbert
+//   x -- real signal with N samples; N must be an integer power of 2
+func Envelope(x []float64) (env []float64, err error) {
+	z, err := Hilbert(x)
+	if err != nil {
+		return
+	}
+	env = make([]float64, len(z))
+	for k := 0; k < len(z); k++ {
+		env[k] = cmplx.Abs(z[k])
+	}
+	return
+}
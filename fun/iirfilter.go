@@ -0,0 +1,325 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// Butterworth designs a low-pass Butterworth infinite impulse response (IIR) filter
+//
+//   Input:
+//     order  -- order n ≥ 1 of the filter
+//     cutoff -- cutoff frequency (-3 dB); 0 < cutoff < fs/2
+//     fs     -- sampling frequency (e.g. in Hz)
+//
+//   Output:
+//     b -- numerator coefficients; len(b) = n+1
+//     a -- denominator coefficients with a[0] = 1; len(a) = n+1
+//
+//            b[0] + b[1] z⁻¹ + … + b[n] z⁻ⁿ
+//     H(z) = ——————————————————————————————
+//            a[0] + a[1] z⁻¹ + … + a[n] z⁻ⁿ
+//
+//   The n poles of the analog prototype are placed on a circle of radius Ωc:
+//
+//                     i π (2k + n + 1) / (2n)
+//       p[k] = Ωc ⋅ e                              k = 0 ... n-1
+//
+//   with the prewarped cutoff Ωc = 2 fs tan(π cutoff / fs). Then the bilinear transform
+//   z = (2 fs + p) / (2 fs - p) maps the poles to the z-plane and the zeros to z = -1.
+//   Finally, b is scaled such that the gain at zero frequency is 1
+//
+//   NOTE: (1) the filter is applied with Filter. The coefficients are the same as computed by
+//             scipy.signal.butter(n, 2⋅cutoff/fs)
+//         (2) for high orders with cutoff ≪ fs, the poles cluster near z = 1 and the polynomial
+//             coefficients b and a become ill-conditioned; e.g. the gain of a 6th-order filter
+//             with cutoff/fs = 0.001 is already inaccurate
+//
+func Butterworth(order int, cutoff, fs float64) (b, a []float64, err error) {
+
+	// check
+	if order < 1 {
+		return nil, nil, chk.Err("the order of the filter must be at least 1. order = %d is invalid", order)
+	}
+	if fs <= 0 {
+		return nil, nil, chk.Err("the sampling frequency must be positive. fs = %g is invalid", fs)
+	}
+	if cutoff <= 0 || cutoff >= fs/2 {
+		return nil, nil, chk.Err("the cutoff frequency must be in (0, fs/2) = (0, %g). cutoff = %g is invalid", fs/2, cutoff)
+	}
+
+	// digital poles
+	n := order
+	Ωc := 2 * fs * math.Tan(math.Pi*cutoff/fs)
+	z := make([]complex128, n)
+	gain := complex(1, 0)
+	for k := 0; k < n; k++ {
+		p := complex(Ωc, 0) * cmplx.Exp(complex(0, math.Pi*float64(2*k+n+1)/float64(2*n)))
+		z[k] = (complex(2*fs, 0) + p) / (complex(2*fs, 0) - p)
+		gain *= (1 - z[k]) / 2 // H(z=1) = 1
+	}
+
+	// denominator: Π (1 - z[k] z⁻¹)
+	c := make([]complex128, n+1)
+	c[0] = 1
+	for k := 0; k < n; k++ {
+		for j := k + 1; j > 0; j-- {
+			c[j] -= z[k] * c[j-1]
+		}
+	}
+	a = make([]float64, n+1)
+	for j := 0; j <= n; j++ {
+		a[j] = real(c[j]) // complex conjugate poles ⇒ real coefficients
+	}
+
+	// numerator: gain ⋅ (1 + z⁻¹)ⁿ
+	b = make([]float64, n+1)
+	binom := 1.0
+	for j := 0; j <= n; j++ {
+		b[j] = real(gain) * binom
+		binom = binom * float64(n-j) / float64(j+1)
+	}
+	return
+}
+
+// Bilinear maps an analog filter (s-domain) to a digital filter (z-domain) by the bilinear
+// transform
+//
+//                 1 - z⁻¹
+//        s = K ⋅ —————————      with K = 2 fs  or  K = 2π fp / tan(π fp / fs) (prewarping)
+//                 1 + z⁻¹
+//
+//   Input:
+//     bAnalog -- numerator coefficients in descending powers of s; e.g. [b0, b1] ⇒ b0 s + b1
+//     aAnalog -- denominator coefficients in descending powers of s
+//     fs      -- sampling frequency (e.g. in Hz)
+//     prewarp -- [optional] frequency fp (0 < fp < fs/2) at which the analog and digital
+//                responses coincide. Without prewarping, the analog frequency Ω is mapped to
+//                the digital frequency f = (fs/π) atan(Ω / (2 fs))
+//
+//   Output:
+//     bDigital -- numerator coefficients in ascending powers of z⁻¹; see Filter
+//     aDigital -- denominator coefficients in ascending powers of z⁻¹ with aDigital[0] = 1
+//
+//   NOTE: the order of the digital filter is n = max(len(bAnalog), len(aAnalog)) - 1; thus
+//         len(bDigital) = len(aDigital) = n + 1. The definition follows scipy.signal.bilinear
+//
+func Bilinear(bAnalog, aAnalog []float64, fs float64, prewarp ...float64) (bDigital, aDigital []float64, err error) {
+
+	// check
+	if len(bAnalog) < 1 || len(aAnalog) < 1 {
+		return nil, nil, chk.Err("the analog coefficients must not be empty. len(bAnalog) = %d and len(aAnalog) = %d are invalid", len(bAnalog), len(aAnalog))
+	}
+	if fs <= 0 {
+		return nil, nil, chk.Err("the sampling frequency must be positive. fs = %g is invalid", fs)
+	}
+	K := 2 * fs
+	switch len(prewarp) {
+	case 0:
+	case 1:
+		fp := prewarp[0]
+		if fp <= 0 || fp >= fs/2 {
+			return nil, nil, chk.Err("the prewarping frequency must be in (0, fs/2) = (0, %g). fp = %g is invalid", fs/2, fp)
+		}
+		K = 2 * math.Pi * fp / math.Tan(math.Pi*fp/fs)
+	default:
+		return nil, nil, chk.Err("at most one prewarping frequency can be given. %d is invalid", len(prewarp))
+	}
+
+	// substitution; multiplying by (1 + z⁻¹)ⁿ
+	n := len(bAnalog) - 1
+	if len(aAnalog)-1 > n {
+		n = len(aAnalog) - 1
+	}
+	bDigital = bilinearPoly(bAnalog, n, K)
+	aDigital = bilinearPoly(aAnalog, n, K)
+	if aDigital[0] == 0 {
+		return nil, nil, chk.Err("the leading digital denominator coefficient is zero; the analog filter has a pole at s = K = %g", K)
+	}
+	den := aDigital[0]
+	for k := 0; k <= n; k++ {
+		bDigital[k] /= den
+		aDigital[k] /= den
+	}
+	return
+}
+
+// bilinearPoly computes the coefficients (in ascending powers of z⁻¹) of
+//
+//          d
+//          Σ  p[i] Kᵈ⁻ⁱ (1 - z⁻¹)ᵈ⁻ⁱ (1 + z⁻¹)ⁿ⁻ᵈ⁺ⁱ       with d = len(p) - 1 ≤ n
+//         i=0
+//
+func bilinearPoly(p []float64, n int, K float64) (c []float64) {
+	c = make([]float64, n+1)
+	d := len(p) - 1
+	for i := 0; i <= d; i++ {
+		m := d - i // power of (1 - z⁻¹)
+		term := make([]float64, n+1)
+		term[0] = p[i] * math.Pow(K, float64(m))
+		deg := 0
+		for j := 0; j < n; j++ { // multiply by (1 - z⁻¹) m times and by (1 + z⁻¹) n-m times
+			sign := 1.0
+			if j < m {
+				sign = -1.0
+			}
+			for k := deg + 1; k > 0; k-- {
+				term[k] += sign * term[k-1]
+			}
+			deg++
+		}
+		for k := 0; k <= n; k++ {
+			c[k] += term[k]
+		}
+	}
+	return
+}
+
+// Filter applies the IIR (or FIR) digital filter with coefficients b and a to the signal x
+//
+//   Computes (direct form II):
+//
+//     w[j] = (x[j] - a[1] w[j-1] - … - a[m] w[j-m]) / a[0]
+//     y[j] =  b[0] w[j] + b[1] w[j-1] + … + b[m] w[j-m]
+//
+//   with zero initial conditions; i.e. w[j] = 0 for j < 0
+//
+//   b -- numerator coefficients
+//   a -- denominator coefficients; a[0] ≠ 0. Use a = [1] for FIR filters
+//   x -- input signal
+//
+//   NOTE: the result is the same as computed by scipy.signal.lfilter(b, a, x)
+//
+func Filter(b, a, x []float64) (y []float64) {
+
+	// check
+	if len(b) < 1 || len(a) < 1 {
+		chk.Panic("the coefficients b and a must not be empty. len(b) = %d and len(a) = %d are invalid\n", len(b), len(a))
+	}
+	if a[0] == 0 {
+		chk.Panic("the first denominator coefficient a[0] must not be zero\n")
+	}
+
+	// coefficients normalised by a[0] and padded to the same length
+	m := len(a)
+	if len(b) > m {
+		m = len(b)
+	}
+	bb := make([]float64, m)
+	aa := make([]float64, m)
+	for k := 0; k < len(b); k++ {
+		bb[k] = b[k] / a[0]
+	}
+	for k := 0; k < len(a); k++ {
+		aa[k] = a[k] / a[0]
+	}
+
+	// filter; w holds the current state w[j] and the m-1 previous states
+	y = make([]float64, len(x))
+	w := make([]float64, m)
+	for j := 0; j < len(x); j++ {
+		w[0] = x[j]
+		for k := 1; k < m; k++ {
+			w[0] -= aa[k] * w[k]
+		}
+		for k := 0; k < m; k++ {
+			y[j] += bb[k] * w[k]
+		}
+		copy(w[1:], w[:m-1])
+	}
+	return
+}
+
+// GroupDelay computes the group delay τ(ω) = -dφ/dω of the digital filter with coefficients b
+// and a, where φ is the phase of the frequency response H(e^{iω}) = B(e^{iω}) / A(e^{iω})
+//
+//   Input:
+//     b      -- numerator coefficients
+//     a      -- denominator coefficients; use a = [1] for FIR filters
+//     nFreqs -- number of frequencies; must be an integer power of 2
+//
+//   Output:
+//     freqs -- normalised angular frequencies ω[j] = π j / nFreqs ∈ [0, π) (radians/sample)
+//     gd    -- group delay (in samples) at each frequency
+//
+//   The phase is not differentiated numerically. Instead, the group delay of each polynomial
+//   P(z) = Σ p[k] z⁻ᵏ is computed from
+//
+//                 ⎛ DFT(k ⋅ p[k]) ⎞
+//        τ_P = Re ⎜ ————————————— ⎟         and then τ = τ_B - τ_A
+//                 ⎝   DFT(p[k])   ⎠
+//
+//   where the DFTs of length 2⋅nFreqs are computed by FFT
+//
+//   NOTE: at frequencies where B or A is zero (e.g. zeros on the unit circle), the group delay
+//         is not defined and gd = 0 is returned
+//
+func GroupDelay(b, a []float64, nFreqs int) (freqs, gd []float64, err error) {
+
+	// check
+	if len(b) < 1 || len(a) < 1 {
+		return nil, nil, chk.Err("the coefficients b and a must not be empty. len(b) = %d and len(a) = %d are invalid", len(b), len(a))
+	}
+	if !utl.IsPowerOfTwo(nFreqs) {
+		return nil, nil, chk.Err("the number of frequencies must be power of 2. nFreqs = %d is invalid", nFreqs)
+	}
+
+	// group delays of numerator and denominator
+	L := 2 * nFreqs
+	τb, okb, err := polyGroupDelay(b, L)
+	if err != nil {
+		return
+	}
+	τa, oka, err := polyGroupDelay(a, L)
+	if err != nil {
+		return
+	}
+
+	// results
+	freqs = make([]float64, nFreqs)
+	gd = make([]float64, nFreqs)
+	for j := 0; j < nFreqs; j++ {
+		freqs[j] = math.Pi * float64(j) / float64(nFreqs)
+		if okb[j] && oka[j] {
+			gd[j] = τb[j] - τa[j]
+		}
+	}
+	return
+}
+
+// polyGroupDelay computes the group delay of the polynomial Σ p[k] z⁻ᵏ at the L frequencies
+// 2π j / L; ok[j] is false where the polynomial is (numerically) zero
+//   NOTE: the coefficients are folded modulo L; thus len(p) > L is allowed
+func polyGroupDelay(p []float64, L int) (τ []float64, ok []bool, err error) {
+	P := make([]complex128, L)
+	K := make([]complex128, L)
+	pmax := 0.0
+	for k, v := range p {
+		P[k%L] += complex(v, 0)
+		K[k%L] += complex(float64(k)*v, 0)
+		pmax = math.Max(pmax, math.Abs(v))
+	}
+	if err = DftInplace(P, false); err != nil {
+		return
+	}
+	if err = DftInplace(K, false); err != nil {
+		return
+	}
+	τ = make([]float64, L)
+	ok = make([]bool, L)
+	tol := 1e-12 * pmax * float64(len(p))
+	for j := 0; j < L; j++ {
+		if cmplx.Abs(P[j]) > tol {
+			τ[j] = real(K[j] / P[j])
+			ok[j] = true
+		}
+	}
+	return
+}
@@ -0,0 +1,79 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import "math"
+
+// JacobiElliptic computes the Jacobi elliptic functions sn(u|m), cn(u|m) and dn(u|m) with
+// parameter m = k² by means of the descending Landen (AGM) transformation [1]
+//
+//   Computes:
+//     sn = sin(φ),  cn = cos(φ)  and  dn = √(1 - m sin²(φ))   where φ = am(u|m) satisfies
+//
+//           φ
+//          ⌠          dt
+//     u =  │  _________________
+//          │    _____________
+//          ⌡  \╱ 1 - m sin²(t)
+//         0
+//
+//   The AGM sequence a[0] = 1, b[0] = √(1-m), c[0] = √m is computed until c[N] is negligible;
+//   then φ[N] = 2ᴺ a[N] u and, for n = N ... 1:
+//
+//     φ[n-1] = (φ[n] + asin(c[n] sin(φ[n]) / a[n])) / 2
+//
+//   finally, sn = sin(φ[0]) and cn = cos(φ[0])
+//
+//   NOTE: (1) 0 ≤ m ≤ 1; the results are NaN for m outside [0, 1]
+//         (2) dn is computed from dn² = (1 - m) + m cn², which has no cancellation
+//         (3) m = 0 gives sn = sin(u), cn = cos(u), dn = 1 and m = 1 gives sn = tanh(u),
+//             cn = dn = sech(u)
+//
+//   Reference:
+//   [1] Abramowitz M, Stegun IA (1972) Handbook of Mathematical Functions with Formulas, Graphs,
+//       and Mathematical Tables. U.S. Department of Commerce, NIST. Section 16.4
+//
+func JacobiElliptic(u, m float64) (sn, cn, dn float64) {
+
+	// check
+	if m < 0 || m > 1 || math.IsNaN(m) {
+		return math.NaN(), math.NaN(), math.NaN()
+	}
+
+	// limits
+	if m == 0 {
+		sn, cn = math.Sincos(u)
+		return sn, cn, 1
+	}
+	if m == 1 {
+		sech := 1.0 / math.Cosh(u)
+		return math.Tanh(u), sech, sech
+	}
+
+	// descending AGM
+	const nmax = 16
+	var a, c [nmax + 1]float64
+	a[0] = 1.0
+	b := math.Sqrt(1 - m)
+	n := 0
+	for n < nmax {
+		a[n+1] = 0.5 * (a[n] + b)
+		c[n+1] = 0.5 * (a[n] - b)
+		b = math.Sqrt(a[n] * b)
+		n++
+		if math.Abs(c[n]) <= 1e-16*a[n] {
+			break
+		}
+	}
+
+	// backward substitution
+	φ := math.Ldexp(a[n]*u, n)
+	for ; n > 0; n-- {
+		φ = 0.5 * (φ + math.Asin(c[n]*math.Sin(φ)/a[n]))
+	}
+	sn, cn = math.Sincos(φ)
+	dn = math.Sqrt((1 - m) + m*cn*cn)
+	return
+}
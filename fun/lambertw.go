@@ -0,0 +1,105 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// LambertW0 computes the principal branch W₀(x) of the Lambert W function; i.e. the solution
+// w ≥ -1 of w ⋅ exp(w) = x
+//
+//   Input:
+//     x -- argument; x ≥ -1/e
+//
+//   Output:
+//     w -- W₀(x) ∈ [-1, ∞)
+//
+//   NOTE: the initial guess is given by the series about the branch point (x near -1/e), by
+//         w ≈ ln(1+x) (1 - ln(1+ln(1+x)) / (2+ln(1+x))) for moderate x, or by the asymptotic
+//         expansion w ≈ L₁ - L₂ + L₂/L₁ with L₁ = ln(x) and L₂ = ln(L₁) for large x. Then, the
+//         solution is refined by Halley's method
+//
+func LambertW0(x float64) (w float64, err error) {
+	if math.IsNaN(x) || x < -1/math.E {
+		return 0, chk.Err("the argument of W₀ must be greater than or equal to -1/e. x = %g is invalid", x)
+	}
+	switch {
+	case x == 0 || math.IsInf(x, +1):
+		return x, nil
+	case x == -1/math.E:
+		return -1, nil
+	case x < -0.25:
+		p := math.Sqrt(2 * (math.E*x + 1))
+		w = -1 + p*(1+p*(-1.0/3+p*11.0/72))
+	case x < 3:
+		l := math.Log1p(x)
+		w = l * (1 - math.Log1p(l)/(2+l))
+	default:
+		l1 := math.Log(x)
+		l2 := math.Log(l1)
+		w = l1 - l2 + l2/l1
+	}
+	return lambertHalley(x, w)
+}
+
+// LambertWm1 computes the lower branch W₋₁(x) of the Lambert W function; i.e. the solution
+// w ≤ -1 of w ⋅ exp(w) = x
+//
+//   Input:
+//     x -- argument; -1/e ≤ x < 0
+//
+//   Output:
+//     w -- W₋₁(x) ∈ (-∞, -1]
+//
+//   NOTE: the initial guess is given by the series about the branch point (x near -1/e) or by
+//         w ≈ L₁ - L₂ + L₂/L₁ with L₁ = ln(-x) and L₂ = ln(-L₁) (x near 0). Then, the solution
+//         is refined by Halley's method
+//
+func LambertWm1(x float64) (w float64, err error) {
+	if math.IsNaN(x) || x < -1/math.E || x >= 0 {
+		return 0, chk.Err("the argument of W₋₁ must be in [-1/e, 0). x = %g is invalid", x)
+	}
+	switch {
+	case x == -1/math.E:
+		return -1, nil
+	case x < -0.25:
+		p := -math.Sqrt(2 * (math.E*x + 1))
+		w = -1 + p*(1+p*(-1.0/3+p*11.0/72))
+	default:
+		l1 := math.Log(-x)
+		l2 := math.Log(-l1)
+		w = l1 - l2 + l2/l1
+	}
+	return lambertHalley(x, w)
+}
+
+// lambertHalley solves w ⋅ exp(w) = x by Halley's method starting from w
+//
+//                           f                                  w
+//   w ← w - ——————————————————————————————————     f = w ⋅ exp   - x
+//            exp(w) (w+1) - (w+2) f / (2w + 2)
+//
+func lambertHalley(x, w float64) (float64, error) {
+	for it := 0; it < 100; it++ {
+		ew := math.Exp(w)
+		f := w*ew - x
+		if math.Abs(f) <= 4e-16*math.Abs(x) { // also stops near the branch point where f' ≈ 0
+			return w, nil
+		}
+		den := ew*(w+1) - (w+2)*f/(2*w+2)
+		if den == 0 || w == -1 {
+			return w, nil // branch point
+		}
+		dw := f / den
+		w -= dw
+		if math.Abs(dw) <= 1e-15*(1+math.Abs(w)) {
+			return w, nil
+		}
+	}
+	return w, chk.Err("Halley's method did not converge for x = %g", x)
+}
@@ -0,0 +1,127 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// LegendreAssoc computes the associated Legendre function Pₗᵐ(x) for integers 0 ≤ m ≤ l and
+// -1 ≤ x ≤ 1, including the Condon-Shortley phase (-1)ᵐ
+//
+//   Computes:
+//                           m/2   dᵐ
+//     Pₗᵐ(x) = (-1)ᵐ (1 - x²)    ——— Pₗ(x)     where Pₗ is the Legendre polynomial
+//                                dxᵐ
+//
+//   The recurrence in l starts from
+//
+//     Pₘᵐ(x) = (-1)ᵐ (2m-1)!! (1 - x²)^(m/2)   and   Pₘ₊₁ᵐ(x) = x (2m+1) Pₘᵐ(x)
+//
+//   followed by  (l - m) Pₗᵐ = x (2l-1) Pₗ₋₁ᵐ - (l+m-1) Pₗ₋₂ᵐ  which is stable for increasing l
+//
+//   Examples:
+//     P₁⁰(x) = x    P₁¹(x) = -√(1-x²)    P₂¹(x) = -3 x √(1-x²)    P₂²(x) = 3 (1-x²)
+//
+//   NOTE: (1) the result is 0 if m > l and NaN if |x| > 1
+//         (2) the values grow quickly with m; e.g. Pₗˡ(0) = (-1)ˡ (2l-1)!!, which overflows for
+//             l > 150. Normalised functions (spherical harmonics) should be used for large m
+//
+func LegendreAssoc(l, m int, x float64) float64 {
+
+	// check
+	if l < 0 || m < 0 {
+		chk.Panic("l and m must be non-negative. l = %d and m = %d are invalid\n", l, m)
+	}
+	if math.Abs(x) > 1 || math.IsNaN(x) {
+		return math.NaN()
+	}
+	if m > l {
+		return 0
+	}
+
+	// P[m][m]
+	pmm := 1.0
+	if m > 0 {
+		somx2 := math.Sqrt((1 - x) * (1 + x))
+		fact := 1.0
+		for i := 1; i <= m; i++ {
+			pmm *= -fact * somx2
+			fact += 2
+		}
+	}
+	if l == m {
+		return pmm
+	}
+
+	// P[m+1][m]
+	pmmp1 := x * float64(2*m+1) * pmm
+	if l == m+1 {
+		return pmmp1
+	}
+
+	// P[l][m]
+	var pll float64
+	for ll := m + 2; ll <= l; ll++ {
+		pll = (x*float64(2*ll-1)*pmmp1 - float64(ll+m-1)*pmm) / float64(ll-m)
+		pmm = pmmp1
+		pmmp1 = pll
+	}
+	return pll
+}
+
+// SphericalHarmonic computes the real (tesseral) spherical harmonic Yₗᵐ(θ, φ) for -l ≤ m ≤ l
+//
+//   Computes:
+//              ⎧ √2 Nₗᵐ Pₗᵐ(cos θ) cos(m φ)         if m > 0
+//     Yₗᵐ  =  ⎨    Nₗ⁰ Pₗ⁰(cos θ)                  if m = 0
+//              ⎩ √2 Nₗ|m| Pₗ|m|(cos θ) sin(|m| φ)   if m < 0
+//
+//   with  Nₗᵐ = √[ (2l+1)/(4π) ⋅ (l-|m|)!/(l+|m|)! ]  and Pₗᵐ without the Condon-Shortley phase
+//
+//   Input:
+//     θ -- polar angle (colatitude) ∈ [0, π]
+//     φ -- azimuthal angle (longitude)
+//
+//   NOTE: (1) the functions are orthonormal on the unit sphere:
+//             ∫∫ Yₗᵐ Yₖⁿ sin(θ) dθ dφ = δₗₖ δₘₙ
+//         (2) the Condon-Shortley phase (-1)ᵐ of LegendreAssoc is removed, as usual in geodesy
+//             and for real harmonics; e.g. Y₁¹ = √(3/(4π)) sin(θ) cos(φ) = √(3/(4π)) x/r
+//
+func SphericalHarmonic(l, m int, θ, φ float64) float64 {
+
+	// check
+	am := m
+	if m < 0 {
+		am = -m
+	}
+	if am > l {
+		chk.Panic("|m| must not be greater than l. l = %d and m = %d are invalid\n", l, m)
+	}
+
+	// normalisation: (2l+1)/(4π) (l-|m|)!/(l+|m|)!
+	nrm := float64(2*l+1) / (4.0 * math.Pi)
+	for k := l - am + 1; k <= l+am; k++ {
+		nrm /= float64(k)
+	}
+	nrm = math.Sqrt(nrm)
+
+	// Legendre function without the Condon-Shortley phase
+	p := LegendreAssoc(l, am, math.Cos(θ))
+	if am%2 == 1 {
+		p = -p
+	}
+
+	// harmonic
+	switch {
+	case m > 0:
+		return math.Sqrt2 * nrm * p * math.Cos(float64(m)*φ)
+	case m < 0:
+		return math.Sqrt2 * nrm * p * math.Sin(float64(am)*φ)
+	}
+	return nrm * p
+}
@@ -0,0 +1,134 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// HzToMel converts the frequency f (in Hz) to the mel scale: m = 2595 log10(1 + f/700)
+func HzToMel(f float64) float64 {
+	return 2595.0 * math.Log10(1.0+f/700.0)
+}
+
+// MelToHz converts m (in mels) to the frequency in Hz: f = 700 (10^(m/2595) - 1); see HzToMel
+func MelToHz(m float64) float64 {
+	return 700.0 * (math.Pow(10.0, m/2595.0) - 1.0)
+}
+
+// MelFilterbank returns a set of triangular filters equally spaced on the mel scale
+//
+//   nFilters -- number of filters; nFilters ≥ 1
+//   fftLen   -- length of the frames; the filters act on the fftLen/2+1 bins of the half
+//               spectrum (see RealFourierTrans) with frequencies f[k] = k ⋅ fs / fftLen
+//   fs       -- sampling frequency in Hz
+//   fmin     -- lowest frequency (edge of the first filter); 0 ≤ fmin < fmax
+//   fmax     -- highest frequency (edge of the last filter); fmax ≤ fs/2
+//
+//   Output:
+//     fb -- [nFilters][fftLen/2+1] weights. With the nFilters+2 frequencies c[0] ... c[nFilters+1]
+//           equally spaced in mels from fmin to fmax, filter i rises linearly from 0 at c[i] to
+//           1 at c[i+1] and falls back to 0 at c[i+2]
+//
+//   NOTE: (1) the filters are not normalised (unit peak); thus the sum of all filters is equal
+//             to 1 between the centres c[1] and c[nFilters] (partition of unity)
+//         (2) the mel scale is the one of HzToMel (O'Shaughnessy/HTK formula)
+//
+func MelFilterbank(nFilters, fftLen int, fs, fmin, fmax float64) (fb [][]float64) {
+
+	// check
+	if nFilters < 1 {
+		chk.Panic("the number of filters must be at least 1. nFilters = %d is invalid\n", nFilters)
+	}
+	if fftLen < 2 {
+		chk.Panic("the length of frames must be at least 2. fftLen = %d is invalid\n", fftLen)
+	}
+	if fs <= 0 {
+		chk.Panic("the sampling frequency must be positive. fs = %g is invalid\n", fs)
+	}
+	if fmin < 0 || fmin >= fmax || fmax > fs/2 {
+		chk.Panic("the frequencies must satisfy 0 ≤ fmin < fmax ≤ fs/2 = %g. fmin = %g and fmax = %g are invalid\n", fs/2, fmin, fmax)
+	}
+
+	// edges and centres of the filters
+	mmin, mmax := HzToMel(fmin), HzToMel(fmax)
+	c := make([]float64, nFilters+2)
+	for i := 0; i < nFilters+2; i++ {
+		c[i] = MelToHz(mmin + (mmax-mmin)*float64(i)/float64(nFilters+1))
+	}
+	c[0], c[nFilters+1] = fmin, fmax // avoid round-off at the ends
+
+	// weights
+	nbins := fftLen/2 + 1
+	fb = make([][]float64, nFilters)
+	for i := 0; i < nFilters; i++ {
+		fb[i] = make([]float64, nbins)
+		for k := 0; k < nbins; k++ {
+			f := float64(k) * fs / float64(fftLen)
+			switch {
+			case f > c[i] && f <= c[i+1]:
+				fb[i][k] = (f - c[i]) / (c[i+1] - c[i])
+			case f > c[i+1] && f < c[i+2]:
+				fb[i][k] = (c[i+2] - f) / (c[i+2] - c[i+1])
+			}
+		}
+	}
+	return
+}
+
+// MelSpectrogram computes the mel spectrogram of a signal; i.e. the power of the frames of the
+// short-time Fourier transform (see Spectrogram) in the bands of MelFilterbank
+//
+//   signal   -- real signal
+//   winLen   -- length of each frame; must be an integer power of 2
+//   hop      -- number of samples between the start of consecutive frames; hop ≥ 1
+//   nFilters -- number of mel bands
+//   fs       -- sampling frequency in Hz
+//
+//   Output:
+//     mel -- [nframes][nFilters] with mel[i][j] = Σ_k fb[j][k] ⋅ |X_i[k]|² where X_i is the
+//            half spectrum of frame i and fb = MelFilterbank(nFilters, winLen, fs, 0, fs/2)
+//
+//   NOTE: the frames are multiplied by the Hann window
+//
+func MelSpectrogram(signal []float64, winLen, hop, nFilters int, fs float64) (mel [][]float64, err error) {
+
+	// check
+	if nFilters < 1 {
+		return nil, chk.Err("the number of filters must be at least 1. nFilters = %d is invalid", nFilters)
+	}
+	if fs <= 0 {
+		return nil, chk.Err("the sampling frequency must be positive. fs = %g is invalid", fs)
+	}
+	if winLen < 2 {
+		return nil, chk.Err("the window length must be at least 2. winLen = %d is invalid", winLen)
+	}
+
+	// frames
+	win, err := Window("hann", winLen)
+	if err != nil {
+		return
+	}
+	frames, err := Spectrogram(signal, winLen, hop, win)
+	if err != nil {
+		return
+	}
+
+	// apply filterbank to the power of each frame
+	fb := MelFilterbank(nFilters, winLen, fs, 0, fs/2)
+	mel = make([][]float64, len(frames))
+	for i, X := range frames {
+		mel[i] = make([]float64, nFilters)
+		for k, v := range X {
+			p := real(v)*real(v) + imag(v)*imag(v)
+			for j := 0; j < nFilters; j++ {
+				mel[i][j] += fb[j][k] * p
+			}
+		}
+	}
+	return
+}
@@ -141,6 +141,105 @@ func ModBesselKn(n int, x float64) float64 {
 	return bk
 }
 
+// BesselI returns the modified Bessel function of the first kind In(x) for integer n and any
+// real x
+//
+//   NOTE: (1) for |x| ≤ 2, the power series Σ (x/2)^(2k+n) / (k! (n+k)!) is used
+//         (2) for |x| > max(50, n²), the asymptotic expansion for large arguments is used
+//         (3) otherwise, ModBesselIn (downward recurrence normalised with I0) is used
+//         (4) negative n and x are handled by I[-n](x) = In(x) and In(-x) = (-1)ⁿ In(x)
+//
+func BesselI(n int, x float64) float64 {
+	if n < 0 {
+		n = -n
+	}
+	sign := 1.0
+	if x < 0 && n&1 != 0 {
+		sign = -1.0
+	}
+	ax := math.Abs(x)
+	if ax <= 2 {
+		return sign * besselIseries(n, ax)
+	}
+	if ax > math.Max(50, float64(n*n)) {
+		return sign * math.Exp(ax) / math.Sqrt(2*math.Pi*ax) * besselAsympSum(n, ax, true)
+	}
+	return sign * ModBesselIn(n, ax)
+}
+
+// BesselK returns the modified Bessel function of the second kind Kn(x) for integer n and x > 0
+//
+//   NOTE: (1) for x > max(50, n²), the asymptotic expansion for large arguments is used
+//         (2) otherwise, ModBesselKn (upward recurrence from K0 and K1) is used
+//         (3) negative n is handled by K[-n](x) = Kn(x)
+//
+//   Special cases:
+//     Kn(x=0) = +Inf
+//     Kn(x<0) = NaN
+//
+func BesselK(n int, x float64) float64 {
+	if n < 0 {
+		n = -n
+	}
+	if x < 0 || math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x == 0 {
+		return math.Inf(+1)
+	}
+	if x > math.Max(50, float64(n*n)) {
+		return math.Sqrt(math.Pi/(2*x)) * math.Exp(-x) * besselAsympSum(n, x, false)
+	}
+	return ModBesselKn(n, x)
+}
+
+// besselIseries computes In(x) by means of the power series
+func besselIseries(n int, x float64) (sum float64) {
+	h := x / 2
+	term := 1.0
+	for k := 1; k <= n; k++ {
+		term *= h / float64(k)
+	}
+	for k := 1; k < 500; k++ {
+		sum += term
+		term *= h * h / float64(k*(n+k))
+		if term < 1e-17*sum {
+			break
+		}
+	}
+	return sum + term
+}
+
+// besselAsympSum computes the sum of the asymptotic expansion of In(x) or Kn(x) for large x
+//
+//         ∞     k  a[k]              (4n² - 1²)(4n² - 3²) ... (4n² - (2k-1)²)
+//   S  =  Σ  (±1)  ————     a[k] = ——————————————————————————————————————————
+//        k=0        xᵏ                            k! 8ᵏ
+//
+//   where the sign alternates for In (alternate = true)
+//
+func besselAsympSum(n int, x float64, alternate bool) (sum float64) {
+	μ := 4.0 * float64(n*n)
+	term := 1.0
+	sum = 1.0
+	for k := 1; k < 100; k++ {
+		odd := float64(2*k - 1)
+		next := term * (μ - odd*odd) / (float64(k) * 8.0 * x)
+		if math.Abs(next) >= math.Abs(term) { // the series is asymptotic: stop at the smallest term
+			break
+		}
+		term = next
+		if alternate {
+			term = -term
+		}
+		sum += term
+		if math.Abs(term) < 1e-17*math.Abs(sum) {
+			break
+		}
+	}
+	return
+}
+
 // mbpoly evaluate a polynomial for the modified Bessel functions
 func mbpoly(cof []float64, n int, x float64) (ans float64) {
 	ans = cof[n]
@@ -0,0 +1,93 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// MovingAverage computes the average of x over a sliding window
+//
+//                  1    window-1
+//        a[i] = ——————    Σ    x[i+j]       i = 0 ... n-window
+//               window   j=0
+//
+//   x      -- data with n = len(x) values
+//   window -- number of points of the sliding window; 1 ≤ window ≤ n
+//
+//   NOTE: (1) the output corresponds to the "valid" mode; i.e. len(a) = n - window + 1 and
+//             a[i] is the average of x[i:i+window]. Thus, a[i] is aligned with the centre
+//             x[i + (window-1)/2] of the window
+//         (2) a running sum is used; i.e. the cost is O(n) independently of window. The sum is
+//             recomputed from scratch every window steps to avoid the accumulation of round-off
+//
+func MovingAverage(x []float64, window int) (a []float64, err error) {
+	a, err = movingSum(x, window, false)
+	if err != nil {
+		return
+	}
+	for i := range a {
+		a[i] /= float64(window)
+	}
+	return
+}
+
+// MovingRMS computes the root mean square of x over a sliding window
+//
+//                  ⎛   1    window-1          ⎞ ½
+//        r[i] =   ⎜ ——————    Σ    x[i+j]²  ⎟          i = 0 ... n-window
+//                  ⎝ window   j=0             ⎠
+//
+//   x      -- data with n = len(x) values
+//   window -- number of points of the sliding window; 1 ≤ window ≤ n
+//
+//   NOTE: the output length and alignment are the same as in MovingAverage ("valid" mode) and
+//         a running sum of squares is used
+//
+func MovingRMS(x []float64, window int) (r []float64, err error) {
+	r, err = movingSum(x, window, true)
+	if err != nil {
+		return
+	}
+	for i := range r {
+		r[i] = math.Sqrt(math.Max(r[i], 0) / float64(window)) // max: round-off of running sum
+	}
+	return
+}
+
+// movingSum computes the sums of x[i:i+window] (or of the squares) with a running sum, which is
+// restarted every window steps; thus the total cost is about 3n additions
+func movingSum(x []float64, window int, squares bool) (s []float64, err error) {
+	n := len(x)
+	if window < 1 || window > n {
+		return nil, chk.Err("the window must satisfy 1 ≤ window ≤ len(x) = %d. window = %d is invalid", n, window)
+	}
+	v := func(i int) float64 {
+		if squares {
+			return x[i] * x[i]
+		}
+		return x[i]
+	}
+	s = make([]float64, n-window+1)
+	sum := 0.0
+	for j := 0; j < window; j++ {
+		sum += v(j)
+	}
+	s[0] = sum
+	for i := 1; i < len(s); i++ {
+		if i%window == 0 { // restart to avoid the accumulation of round-off errors
+			sum = 0
+			for j := i; j < i+window; j++ {
+				sum += v(j)
+			}
+		} else {
+			sum += v(i+window-1) - v(i-1)
+		}
+		s[i] = sum
+	}
+	return
+}
@@ -0,0 +1,169 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// NttPrime is a prime modulus suitable for the number-theoretic transform: 119⋅2²³ + 1. It
+// allows transforms up to 2²³ values and has primitive root 3
+const NttPrime uint64 = 998244353
+
+// IntConvolve computes the exact linear convolution of a and b modulo mod by means of the
+// number-theoretic transform (NTT)
+//
+//   a, b -- non-negative integers; they are reduced modulo mod
+//   mod  -- prime modulus of the form c⋅2ᵏ + 1 < 2⁶³ with 2ᵏ ≥ len(a)+len(b)-1; e.g. NttPrime
+//
+//   Output:
+//     c -- len(a)+len(b)-1 values with c[k] = (Σ a[j] ⋅ b[k-j]) mod mod
+//
+//   NOTE: (1) the NTT is the DFT over the integers modulo mod; the root of unity exp(-i 2π/N)
+//             is replaced by g^((mod-1)/N) where g is a primitive root of mod; thus the same
+//             Danielson-Lanczos butterflies of FourierTransLL are used with modular arithmetic
+//         (2) no rounding errors occur; the results are the exact convolution if all values
+//             of the true convolution are smaller than mod
+//
+func IntConvolve(a, b []uint64, mod uint64) (c []uint64, err error) {
+
+	// check
+	M, N := len(a), len(b)
+	if M < 1 || N < 1 {
+		return nil, chk.Err("the lengths of a and b must be at least 1. len(a) = %d and len(b) = %d are invalid", M, N)
+	}
+	if mod >= 1<<63 {
+		return nil, chk.Err("the modulus must be smaller than 2⁶³ to avoid overflow. mod = %d is invalid", mod)
+	}
+	if mod < 2 || !new(big.Int).SetUint64(mod).ProbablyPrime(0) {
+		return nil, chk.Err("the modulus must be a prime number. mod = %d is invalid", mod)
+	}
+	L := M + N - 1
+	n := utl.NextPowerOfTwo(L)
+	if (mod-1)%uint64(n) != 0 {
+		return nil, chk.Err("mod-1 must be divisible by the transform size %d. mod = %d is invalid", n, mod)
+	}
+	g := nttPrimitiveRoot(mod)
+
+	// transforms
+	A := make([]uint64, n)
+	B := make([]uint64, n)
+	for j := 0; j < M; j++ {
+		A[j] = a[j] % mod
+	}
+	for j := 0; j < N; j++ {
+		B[j] = b[j] % mod
+	}
+	nttInplace(A, mod, g, false)
+	nttInplace(B, mod, g, false)
+	for k := 0; k < n; k++ {
+		A[k] = mulMod(A[k], B[k], mod)
+	}
+	nttInplace(A, mod, g, true)
+	return A[:L], nil
+}
+
+// nttInplace computes the number-theoretic transform of data (length n = power of 2 dividing
+// mod-1) with the primitive root g. The inverse transform is normalised by n
+func nttInplace(data []uint64, mod, g uint64, inverse bool) {
+
+	// bit reversal section
+	n := len(data)
+	j := 0
+	for i := 0; i < n; i++ {
+		if j > i {
+			data[j], data[i] = data[i], data[j]
+		}
+		m := n >> 1
+		for m >= 1 && j&m != 0 {
+			j ^= m
+			m >>= 1
+		}
+		j |= m
+	}
+
+	// Danielson-Lanczos section
+	for mmax := 1; mmax < n; mmax <<= 1 {
+		istep := mmax << 1
+		wp := powMod(g, (mod-1)/uint64(istep), mod) // root of unity of order istep
+		if inverse {
+			wp = powMod(wp, mod-2, mod)
+		}
+		w := uint64(1)
+		for m := 0; m < mmax; m++ {
+			for i := m; i < n; i += istep {
+				j = i + mmax
+				temp := mulMod(w, data[j], mod)
+				data[j] = (data[i] + mod - temp) % mod
+				data[i] = (data[i] + temp) % mod
+			}
+			w = mulMod(w, wp, mod)
+		}
+	}
+
+	// normalise
+	if inverse {
+		ninv := powMod(uint64(n)%mod, mod-2, mod)
+		for i := 0; i < n; i++ {
+			data[i] = mulMod(data[i], ninv, mod)
+		}
+	}
+}
+
+// nttPrimitiveRoot returns the smallest primitive root of the prime p
+func nttPrimitiveRoot(p uint64) uint64 {
+	if p == 2 {
+		return 1
+	}
+	var factors []uint64 // distinct prime factors of p-1
+	r := p - 1
+	for f := uint64(2); f*f <= r; f++ {
+		if r%f == 0 {
+			factors = append(factors, f)
+			for r%f == 0 {
+				r /= f
+			}
+		}
+	}
+	if r > 1 {
+		factors = append(factors, r)
+	}
+	for g := uint64(2); ; g++ {
+		ok := true
+		for _, f := range factors {
+			if powMod(g, (p-1)/f, p) == 1 {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return g
+		}
+	}
+}
+
+// mulMod returns (a ⋅ b) mod m without overflow
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// powMod returns bᵉ mod m
+func powMod(b, e, m uint64) (res uint64) {
+	res = 1 % m
+	b %= m
+	for e > 0 {
+		if e&1 == 1 {
+			res = mulMod(res, b, m)
+		}
+		b = mulMod(b, b, m)
+		e >>= 1
+	}
+	return
+}
@@ -0,0 +1,133 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"sort"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// Pchip implements the monotone piecewise cubic Hermite interpolant (PCHIP) of Fritsch and
+// Carlson [1]. The interpolant is C¹ and preserves the monotonicity of the data; i.e. it never
+// overshoots between knots
+//
+//   Reference:
+//   [1] Fritsch FN, Carlson RE (1980) Monotone piecewise cubic interpolation. SIAM Journal on
+//       Numerical Analysis, 17(2):238-246
+//
+type Pchip struct {
+	X []float64 // knots (strictly increasing)
+	Y []float64 // values at knots
+	D []float64 // first derivatives at knots
+}
+
+// NewPchip creates a new monotone piecewise cubic Hermite interpolant
+//
+//   Input:
+//     xs -- strictly increasing knots; len(xs) ≥ 2
+//     ys -- values at knots; len(ys) = len(xs)
+//
+//   NOTE: (1) the interior derivatives are the weighted harmonic means of the neighbouring
+//             slopes, or zero if the slopes have different signs or one of them is zero
+//         (2) the end derivatives are computed with a shape-preserving three-point formula
+//         (3) xs and ys are copied
+//
+func NewPchip(xs, ys []float64) (o *Pchip, err error) {
+
+	// check
+	n := len(xs)
+	if len(ys) != n {
+		return nil, chk.Err("lengths of data sets must be the same. %d != %d", n, len(ys))
+	}
+	if n < 2 {
+		return nil, chk.Err("at least 2 knots are required. len(xs) = %d is invalid", n)
+	}
+	for i := 1; i < n; i++ {
+		if xs[i] <= xs[i-1] {
+			return nil, chk.Err("knots must be strictly increasing. xs[%d] = %g ≤ xs[%d] = %g", i, xs[i], i-1, xs[i-1])
+		}
+	}
+
+	// new interpolant
+	o = new(Pchip)
+	o.X = make([]float64, n)
+	o.Y = make([]float64, n)
+	o.D = make([]float64, n)
+	copy(o.X, xs)
+	copy(o.Y, ys)
+
+	// slopes of segments
+	h := make([]float64, n-1)
+	δ := make([]float64, n-1)
+	for i := 0; i < n-1; i++ {
+		h[i] = xs[i+1] - xs[i]
+		δ[i] = (ys[i+1] - ys[i]) / h[i]
+	}
+	if n == 2 {
+		o.D[0], o.D[1] = δ[0], δ[0]
+		return
+	}
+
+	// interior derivatives
+	for i := 1; i < n-1; i++ {
+		if δ[i-1]*δ[i] <= 0 {
+			continue
+		}
+		w1 := 2*h[i] + h[i-1]
+		w2 := h[i] + 2*h[i-1]
+		o.D[i] = (w1 + w2) / (w1/δ[i-1] + w2/δ[i])
+	}
+
+	// end derivatives
+	o.D[0] = pchipEnd(h[0], h[1], δ[0], δ[1])
+	o.D[n-1] = pchipEnd(h[n-2], h[n-3], δ[n-2], δ[n-3])
+	return
+}
+
+// Eval evaluates the interpolant at x
+//
+//   NOTE: outside [X[0], X[n-1]] the end polynomials are used for extrapolation
+//
+func (o *Pchip) Eval(x float64) float64 {
+	return hermiteEval(o.X, o.Y, o.D, x)
+}
+
+// hermiteEval evaluates the piecewise cubic Hermite polynomial with knots X, values Y and first
+// derivatives D at x; outside [X[0], X[n-1]] the end polynomials are used
+func hermiteEval(X, Y, D []float64, x float64) float64 {
+	n := len(X)
+	i := sort.SearchFloat64s(X, x) - 1
+	if i < 0 {
+		i = 0
+	}
+	if i > n-2 {
+		i = n - 2
+	}
+	h := X[i+1] - X[i]
+	t := (x - X[i]) / h
+	t2 := t * t
+	t3 := t2 * t
+	h00 := 2*t3 - 3*t2 + 1
+	h10 := t3 - 2*t2 + t
+	h01 := -2*t3 + 3*t2
+	h11 := t3 - t2
+	return h00*Y[i] + h10*h*D[i] + h01*Y[i+1] + h11*h*D[i+1]
+}
+
+// pchipEnd computes the derivative at an end knot using the non-centred three-point formula
+// with the shape-preserving modifications; h0 and δ0 correspond to the end segment and h1 and δ1
+// to its neighbour
+func pchipEnd(h0, h1, δ0, δ1 float64) (d float64) {
+	d = ((2*h0+h1)*δ0 - h0*δ1) / (h0 + h1)
+	if math.Signbit(d) != math.Signbit(δ0) || d == 0 || δ0 == 0 {
+		return 0
+	}
+	if math.Signbit(δ0) != math.Signbit(δ1) && math.Abs(d) > math.Abs(3*δ0) {
+		return 3 * δ0
+	}
+	return
+}
@@ -0,0 +1,110 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"sort"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// FindPeaks returns the indices of the local maxima (peaks) of y; e.g. the lines in a spectrum
+//
+//   Input:
+//     y           -- data; e.g. the magnitude of a spectrum
+//     minHeight   -- only the peaks with y[i] ≥ minHeight are considered
+//     minDistance -- minimum distance (in samples) between peaks; if two peaks are closer than
+//                    minDistance, only the tallest one is kept. Use 0 or 1 to keep all peaks
+//
+//   Output:
+//     idx -- indices of the peaks in increasing order
+//
+//   NOTE: (1) a peak is a sample that is greater than both neighbours; the first and last
+//             samples are never peaks
+//         (2) for flat peaks (plateaus), the index of the middle sample is returned
+//         (3) clusters are resolved from the tallest peak to the shortest one, as in
+//             scipy.signal.find_peaks
+//
+func FindPeaks(y []float64, minHeight, minDistance float64) (idx []int) {
+
+	// local maxima above minHeight
+	n := len(y)
+	var cand []int
+	for i := 1; i < n-1; i++ {
+		if y[i] <= y[i-1] {
+			continue
+		}
+		j := i // end of plateau
+		for j+1 < n-1 && y[j+1] == y[i] {
+			j++
+		}
+		if y[j+1] < y[i] && y[i] >= minHeight {
+			cand = append(cand, (i+j)/2)
+		}
+		i = j
+	}
+	if minDistance <= 1 || len(cand) < 2 {
+		return cand
+	}
+
+	// remove peaks closer than minDistance to taller peaks
+	order := make([]int, len(cand))
+	for k := range order {
+		order[k] = k
+	}
+	sort.SliceStable(order, func(p, q int) bool { return y[cand[order[p]]] > y[cand[order[q]]] })
+	removed := make([]bool, len(cand))
+	for _, k := range order {
+		if removed[k] {
+			continue
+		}
+		for l := k - 1; l >= 0 && float64(cand[k]-cand[l]) < minDistance; l-- {
+			removed[l] = true
+		}
+		for l := k + 1; l < len(cand) && float64(cand[l]-cand[k]) < minDistance; l++ {
+			removed[l] = true
+		}
+	}
+	for k, i := range cand {
+		if !removed[k] {
+			idx = append(idx, i)
+		}
+	}
+	return
+}
+
+// InterpolatePeak estimates the position and height of a peak between samples by fitting a
+// parabola through y[idx-1], y[idx] and y[idx+1]
+//
+//   Input:
+//     y   -- data; e.g. the magnitude of a spectrum
+//     idx -- index of the peak; e.g. computed by FindPeaks
+//
+//   Output:
+//     pos    -- fractional position of the vertex: pos = idx + p with
+//                        1      y[idx-1] - y[idx+1]
+//                  p  =  — ⋅ ——————————————————————————————
+//                        2    y[idx-1] - 2 y[idx] + y[idx+1]
+//     height -- value at the vertex: height = y[idx] - (y[idx-1] - y[idx+1]) ⋅ p / 4
+//
+//   NOTE: if idx is the first or last index, or if the three values are collinear, pos = idx
+//         and height = y[idx] are returned
+//
+func InterpolatePeak(y []float64, idx int) (pos, height float64) {
+	if idx < 0 || idx >= len(y) {
+		chk.Panic("index of peak is out of range. idx = %d is invalid for len(y) = %d\n", idx, len(y))
+	}
+	pos, height = float64(idx), y[idx]
+	if idx == 0 || idx == len(y)-1 {
+		return
+	}
+	a, b, c := y[idx-1], y[idx], y[idx+1]
+	den := a - 2*b + c
+	if den == 0 {
+		return
+	}
+	p := 0.5 * (a - c) / den
+	return pos + p, b - 0.25*(a-c)*p
+}
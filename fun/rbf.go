@@ -0,0 +1,217 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/la"
+)
+
+// RBF implements the interpolation of scattered data in d dimensions by means of radial basis
+// functions (RBF)
+//
+//              N-1
+//     s(x)  =   Σ  W[i] ⋅ φ(‖x - X[i]‖)  [ + P[0] + P[1] x₀ + ... + P[d] x_{d-1} ]
+//              i=0
+//
+//   where the linear polynomial in brackets is only added with the "thin_plate" kernel
+//
+type RBF struct {
+	Kind string      // kernel: "gaussian", "multiquadric" or "thin_plate"
+	Eps  float64     // shape parameter ε
+	X    [][]float64 // [N][d] nodes
+	W    []float64   // [N] weights
+	P    []float64   // [d+1] coefficients of the linear polynomial ("thin_plate" only)
+	phi  func(r float64) float64
+}
+
+// NewRBF creates a new RBF interpolant passing through the scattered data (points[i], values[i])
+//
+//   Input:
+//     points  -- [N][d] distinct nodes; all with the same dimension d ≥ 1
+//     values  -- [N] values at the nodes
+//     kind    -- kernel φ(r):
+//                  "gaussian"     : φ = exp(-(ε r)²)
+//                  "multiquadric" : φ = √(1 + (ε r)²)
+//                  "thin_plate"   : φ = r² ln(r)      (ε is not used)
+//     epsilon -- shape parameter ε > 0; larger values give narrower kernels
+//
+//   NOTE: (1) the weights are computed in the constructor by solving the dense N×N linear system
+//             Σ_j φ(‖X[i] - X[j]‖) W[j] = values[i]; thus the cost is O(N³)
+//         (2) the thin-plate kernel is only conditionally positive definite; hence a linear
+//             polynomial is added and the system is augmented with the conditions Σ W[j] = 0
+//             and Σ W[j] X[j] = 0. Thus N ≥ d+1 nodes not all on a hyperplane are required;
+//             otherwise the system is singular and an error is returned
+//         (3) with small ε, the Gaussian and multiquadric systems become ill-conditioned
+//         (4) the points are copied
+//
+func NewRBF(points [][]float64, values []float64, kind string, epsilon float64) (o *RBF, err error) {
+
+	// check
+	N := len(points)
+	if N < 1 {
+		return nil, chk.Err("at least one point is required")
+	}
+	if len(values) != N {
+		return nil, chk.Err("the number of values must be equal to the number of points. %d != %d", len(values), N)
+	}
+	d := len(points[0])
+	if d < 1 {
+		return nil, chk.Err("the dimension of points must be at least 1")
+	}
+	for i := 1; i < N; i++ {
+		if len(points[i]) != d {
+			return nil, chk.Err("all points must have the same dimension. len(points[%d]) = %d != %d", i, len(points[i]), d)
+		}
+	}
+	for i := 0; i < N; i++ {
+		for j := i + 1; j < N; j++ {
+			if rbfDist(points[i], points[j]) == 0 {
+				return nil, chk.Err("the points must be distinct. points[%d] = points[%d] = %v", i, j, points[i])
+			}
+		}
+	}
+
+	// new interpolant
+	o = &RBF{Kind: kind, Eps: epsilon}
+	switch kind {
+	case "gaussian":
+		o.phi = func(r float64) float64 { return math.Exp(-epsilon * epsilon * r * r) }
+	case "multiquadric":
+		o.phi = func(r float64) float64 { return math.Sqrt(1 + epsilon*epsilon*r*r) }
+	case "thin_plate":
+		o.phi = func(r float64) float64 {
+			if r == 0 {
+				return 0
+			}
+			return r * r * math.Log(r)
+		}
+	default:
+		return nil, chk.Err("kind of RBF %q is not available. options: \"gaussian\", \"multiquadric\", \"thin_plate\"", kind)
+	}
+	if kind != "thin_plate" && epsilon <= 0 {
+		return nil, chk.Err("the shape parameter must be positive. epsilon = %g is invalid", epsilon)
+	}
+	o.X = make([][]float64, N)
+	for i := 0; i < N; i++ {
+		o.X[i] = make([]float64, d)
+		copy(o.X[i], points[i])
+	}
+
+	// linear system
+	np := 0 // number of polynomial terms
+	if kind == "thin_plate" {
+		np = d + 1
+		if N < np {
+			return nil, chk.Err("at least d+1 = %d points are required with the thin-plate kernel. N = %d is invalid", np, N)
+		}
+		if rbfOnHyperplane(o.X) {
+			return nil, chk.Err("the points must not lie on a hyperplane (e.g. a line in 2D) with the thin-plate kernel")
+		}
+	}
+	A := la.NewMatrix(N+np, N+np)
+	b := la.NewVector(N + np)
+	for i := 0; i < N; i++ {
+		for j := i; j < N; j++ {
+			v := o.phi(rbfDist(o.X[i], o.X[j]))
+			A.Set(i, j, v)
+			A.Set(j, i, v)
+		}
+		if np > 0 {
+			A.Set(i, N, 1)
+			A.Set(N, i, 1)
+			for k := 0; k < d; k++ {
+				A.Set(i, N+1+k, o.X[i][k])
+				A.Set(N+1+k, i, o.X[i][k])
+			}
+		}
+		b[i] = values[i]
+	}
+
+	// solve
+	x := la.NewVector(N + np)
+	la.DenSolve(x, A, b, false)
+	o.W = x[:N]
+	if np > 0 {
+		o.P = x[N:]
+	}
+	return
+}
+
+// Eval evaluates the interpolant at point; len(point) must be equal to the dimension of the nodes
+func (o *RBF) Eval(point []float64) (res float64) {
+	d := len(o.X[0])
+	if len(point) != d {
+		chk.Panic("the dimension of point must be equal to %d. len(point) = %d is invalid\n", d, len(point))
+	}
+	for i, xi := range o.X {
+		res += o.W[i] * o.phi(rbfDist(point, xi))
+	}
+	if o.P != nil {
+		res += o.P[0]
+		for k := 0; k < d; k++ {
+			res += o.P[1+k] * point[k]
+		}
+	}
+	return
+}
+
+// rbfDist returns the Euclidean distance between a and b
+func rbfDist(a, b []float64) float64 {
+	sum := 0.0
+	for k := range a {
+		sum += (a[k] - b[k]) * (a[k] - b[k])
+	}
+	return math.Sqrt(sum)
+}
+
+// rbfOnHyperplane returns true if the points lie on a hyperplane; i.e. if the d columns of the
+// centred coordinates are linearly dependent (computed by the modified Gram-Schmidt method)
+func rbfOnHyperplane(X [][]float64) bool {
+	N, d := len(X), len(X[0])
+	cols := make([][]float64, d)
+	scale := 0.0
+	for k := 0; k < d; k++ {
+		cols[k] = make([]float64, N)
+		mean := 0.0
+		for i := 0; i < N; i++ {
+			mean += X[i][k]
+		}
+		mean /= float64(N)
+		for i := 0; i < N; i++ {
+			cols[k][i] = X[i][k] - mean
+			scale = math.Max(scale, math.Abs(cols[k][i]))
+		}
+	}
+	if scale == 0 {
+		return true
+	}
+	tol := 1e-12 * scale * math.Sqrt(float64(N))
+	for k := 0; k < d; k++ {
+		for j := 0; j < k; j++ {
+			dot := 0.0
+			for i := 0; i < N; i++ {
+				dot += cols[j][i] * cols[k][i]
+			}
+			for i := 0; i < N; i++ {
+				cols[k][i] -= dot * cols[j][i]
+			}
+		}
+		norm := 0.0
+		for i := 0; i < N; i++ {
+			norm += cols[k][i] * cols[k][i]
+		}
+		norm = math.Sqrt(norm)
+		if norm <= tol {
+			return true
+		}
+		for i := 0; i < N; i++ {
+			cols[k][i] /= norm
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// ResampleFFT changes the number of samples of a periodic signal by means of the FFT
+//
+//   x      -- real signal with N samples; N must be an integer power of 2
+//   newLen -- number of samples M of the output; M must be an integer power of 2
+//
+//   Output:
+//     y -- M samples with y[j] ≈ x(j N / M); i.e. the same period is sampled with spacing N/M
+//          times the original one
+//
+//   NOTE: (1) upsampling (M > N): the spectrum is zero-padded; the Nyquist bin of x is split
+//             between the positive and negative frequencies
+//         (2) downsampling (M < N): the spectrum is truncated; thus the frequencies above the new
+//             Nyquist frequency are removed (the bins at the new Nyquist frequency are folded)
+//         (3) the values are scaled by M/N so that the amplitudes are preserved
+//         (4) the signal is assumed periodic; thus non-periodic signals show ringing at the ends
+//
+func ResampleFFT(x []float64, newLen int) (y []float64, err error) {
+
+	// check
+	N, M := len(x), newLen
+	if !utl.IsPowerOfTwo(M) {
+		return nil, chk.Err("the new length must be power of 2. newLen = %d is invalid", M)
+	}
+
+	// spectrum
+	X, err := RealFourierTrans(x)
+	if err != nil {
+		return
+	}
+	if M == N {
+		return RealFourierTransInv(X, N)
+	}
+
+	// new half spectrum
+	Y := make([]complex128, M/2+1)
+	scale := complex(float64(M)/float64(N), 0)
+	if M > N {
+		copy(Y, X)
+		if N > 1 {
+			Y[N/2] = X[N/2] / 2
+		}
+	} else {
+		copy(Y, X[:M/2+1])
+		if M > 1 {
+			Y[M/2] = complex(2*real(X[M/2]), 0)
+		}
+	}
+	for k := 0; k < len(Y); k++ {
+		Y[k] *= scale
+	}
+	return RealFourierTransInv(Y, M)
+}
+
+// SincInterp reconstructs a band-limited signal at arbitrary points from its uniform samples by
+// means of the Whittaker-Shannon interpolation formula
+//
+//                    N-1              π (t - x[0] - j h)                  x[N-1] - x[0]
+//     yNew(t)  =      Σ  y[j] ⋅ Sinc( —————————————————— )      with   h = —————————————
+//                    j=0                      h                               N - 1
+//
+//   where h is the mean spacing of the samples
+//
+//   x    -- N ≥ 2 uniformly spaced (increasing or decreasing) sample positions
+//   y    -- N sample values
+//   xNew -- query points
+//
+//   Output:
+//     yNew -- len(xNew) reconstructed values; yNew = y[j] at the nodes, apart from round-off
+//
+//   NOTE: (1) the reconstruction is exact for signals without frequencies above the Nyquist
+//             frequency 1/(2h) if infinitely many samples are available; with N samples, the
+//             signal must decay towards the ends of x, otherwise the truncation of the sum causes
+//             errors that decay slowly (as 1/distance) away from the ends
+//         (2) the spacing is uniform if |x[j] - (x[0] + j h)| ≤ 1e-10 |h| for all j
+//         (3) the cost is O(N ⋅ len(xNew))
+//
+func SincInterp(x, y []float64, xNew []float64) (yNew []float64, err error) {
+
+	// check
+	n := len(x)
+	if len(y) != n {
+		return nil, chk.Err("the lengths of x and y must be equal. %d != %d", n, len(y))
+	}
+	if n < 2 {
+		return nil, chk.Err("at least 2 samples are required. len(x) = %d is invalid", n)
+	}
+	h := (x[n-1] - x[0]) / float64(n-1)
+	if h == 0 {
+		return nil, chk.Err("the sample positions must be distinct")
+	}
+	for j := 0; j < n; j++ {
+		if math.Abs(x[j]-(x[0]+float64(j)*h)) > 1e-10*math.Abs(h) {
+			return nil, chk.Err("the sample positions must be uniformly spaced. x[%d] = %g deviates from %g", j, x[j], x[0]+float64(j)*h)
+		}
+	}
+
+	// Whittaker-Shannon sum
+	yNew = make([]float64, len(xNew))
+	for i, t := range xNew {
+		u := (t - x[0]) / h // position in units of samples
+		sum := 0.0
+		for j := 0; j < n; j++ {
+			sum += y[j] * Sinc(math.Pi*(u-float64(j)))
+		}
+		yNew[i] = sum
+	}
+	return
+}
@@ -0,0 +1,109 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/la"
+)
+
+// SavitzkyGolay smooths y with the Savitzky-Golay filter; i.e. each point is replaced by the
+// value of the least-squares polynomial fitted over a sliding window
+//
+//   y         -- data with n ≥ window equally spaced values
+//   window    -- number of points of the sliding window; must be odd
+//   polyOrder -- order of the fitted polynomial; 0 ≤ polyOrder < window
+//
+//   Output:
+//     s -- smoothed data with n values
+//
+//   NOTE: (1) the filter is a convolution with coefficients c[j] computed from the normal
+//             equations (Aᵀ A) v = e₀ where A[j][m] = (j - p)ᵐ and p is the position of the
+//             target point in the window; then c[j] = Σ A[j][m] v[m]
+//         (2) in the interior, the window is centred at the target point; near the ends, the
+//             window is kept inside the data and the coefficients are computed for the
+//             off-centre target point (instead of zero-padding)
+//         (3) polynomials of degree ≤ polyOrder are preserved exactly
+//
+func SavitzkyGolay(y []float64, window, polyOrder int) (s []float64, err error) {
+
+	// check
+	n := len(y)
+	if window < 1 || window%2 == 0 {
+		return nil, chk.Err("the window must be a positive odd number. window = %d is invalid", window)
+	}
+	if polyOrder < 0 || polyOrder >= window {
+		return nil, chk.Err("the polynomial order must satisfy 0 ≤ polyOrder < window = %d. polyOrder = %d is invalid", window, polyOrder)
+	}
+	if n < window {
+		return nil, chk.Err("the number of data points must be at least window = %d. len(y) = %d is invalid", window, n)
+	}
+
+	// interior points
+	half := window / 2
+	s = make([]float64, n)
+	c := savitzkyGolayCoefs(window, polyOrder, half)
+	for i := half; i < n-half; i++ {
+		for j := 0; j < window; j++ {
+			s[i] += c[j] * y[i-half+j]
+		}
+	}
+
+	// boundary points; the window is y[0:window] or y[n-window:n]
+	for p := 0; p < half; p++ {
+		c = savitzkyGolayCoefs(window, polyOrder, p)
+		for j := 0; j < window; j++ {
+			s[p] += c[j] * y[j]
+		}
+		c = savitzkyGolayCoefs(window, polyOrder, window-1-p)
+		for j := 0; j < window; j++ {
+			s[n-1-p] += c[j] * y[n-window+j]
+		}
+	}
+	return
+}
+
+// savitzkyGolayCoefs computes the coefficients that give the value at position p of the
+// least-squares polynomial of order polyOrder fitted to window points
+func savitzkyGolayCoefs(window, polyOrder, p int) (c []float64) {
+
+	// Vandermonde-like matrix with scaled abscissae t = (j - p) / h (for conditioning)
+	nc := polyOrder + 1
+	h := math.Max(1, float64(window/2))
+	A := la.NewMatrix(window, nc)
+	for j := 0; j < window; j++ {
+		t := float64(j-p) / h
+		for m := 0; m < nc; m++ {
+			A.Set(j, m, math.Pow(t, float64(m)))
+		}
+	}
+
+	// normal equations: (Aᵀ A) v = e₀
+	AtA := la.NewMatrix(nc, nc)
+	for m := 0; m < nc; m++ {
+		for l := 0; l < nc; l++ {
+			sum := 0.0
+			for j := 0; j < window; j++ {
+				sum += A.Get(j, m) * A.Get(j, l)
+			}
+			AtA.Set(m, l, sum)
+		}
+	}
+	e0 := la.NewVector(nc)
+	e0[0] = 1
+	v := la.NewVector(nc)
+	la.DenSolve(v, AtA, e0, false)
+
+	// coefficients: c = A ⋅ v
+	c = make([]float64, window)
+	for j := 0; j < window; j++ {
+		for m := 0; m < nc; m++ {
+			c[j] += A.Get(j, m) * v[m]
+		}
+	}
+	return
+}
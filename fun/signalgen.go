@@ -0,0 +1,88 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// Sine generates n samples of a sinusoidal signal
+//
+//   x[j] = amp ⋅ sin(2π ⋅ freq ⋅ t[j] + phase)      with t[j] = j / fs
+//
+//   freq  -- frequency (e.g. in Hz)
+//   amp   -- amplitude
+//   phase -- phase shift in radians
+//   fs    -- sampling frequency; fs > 0
+//   n     -- number of samples
+//
+func Sine(freq, amp, phase, fs float64, n int) (x []float64) {
+	checkSignalGen(fs, n)
+	x = make([]float64, n)
+	for j := 0; j < n; j++ {
+		x[j] = amp * math.Sin(2*math.Pi*freq*float64(j)/fs+phase)
+	}
+	return
+}
+
+// Chirp generates n samples of a linear frequency sweep (chirp) with unit amplitude
+//
+//   x[j] = sin(2π ⋅ (f0 ⋅ t + (f1 - f0) ⋅ t² / (2 T)))      with t = j / fs and T = (n-1) / fs
+//
+//   i.e. the instantaneous frequency f(t) = f0 + (f1 - f0) t / T increases (or decreases)
+//   linearly from f0 at the first sample to f1 at the last sample
+//
+//   f0, f1 -- initial and final frequencies (e.g. in Hz)
+//   fs     -- sampling frequency; fs > 0
+//   n      -- number of samples
+//
+func Chirp(f0, f1, fs float64, n int) (x []float64) {
+	checkSignalGen(fs, n)
+	x = make([]float64, n)
+	if n == 1 {
+		return
+	}
+	T := float64(n-1) / fs
+	for j := 0; j < n; j++ {
+		t := float64(j) / fs
+		x[j] = math.Sin(2 * math.Pi * (f0*t + 0.5*(f1-f0)*t*t/T))
+	}
+	return
+}
+
+// Square generates n samples of a square wave with unit amplitude
+//
+//   x[j] = +1 in the first half of each period and -1 in the second half; i.e. the signs of
+//   sin(2π ⋅ freq ⋅ j / fs) with x = +1 at the start of each period
+//
+//   freq -- frequency (e.g. in Hz)
+//   fs   -- sampling frequency; fs > 0
+//   n    -- number of samples
+//
+func Square(freq, fs float64, n int) (x []float64) {
+	checkSignalGen(fs, n)
+	x = make([]float64, n)
+	for j := 0; j < n; j++ {
+		c := freq * float64(j) / fs // number of cycles
+		if c-math.Floor(c) < 0.5 {
+			x[j] = 1
+		} else {
+			x[j] = -1
+		}
+	}
+	return
+}
+
+// checkSignalGen checks the arguments of the signal generators
+func checkSignalGen(fs float64, n int) {
+	if fs <= 0 {
+		chk.Panic("the sampling frequency must be positive. fs = %g is invalid\n", fs)
+	}
+	if n < 0 {
+		chk.Panic("the number of samples must be non-negative. n = %d is invalid\n", n)
+	}
+}
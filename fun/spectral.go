@@ -0,0 +1,387 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// Spectrogram computes the short-time Fourier transform (STFT) of a signal
+//
+//   signal -- real signal
+//   winLen -- length of each frame; must be an integer power of 2
+//   hop    -- number of samples between the start of consecutive frames; hop ≥ 1
+//   win    -- window coefficients with len(win) = winLen (see Window); use nil for the
+//             rectangular window
+//
+//   Output:
+//     frames -- frames[i] is the half spectrum (see RealFourierTrans) of the windowed segment
+//               signal[i*hop : i*hop+winLen]; i.e. it has winLen/2+1 bins
+//
+//   NOTE: the frames cover the whole signal; thus the last frame may be a partial segment that
+//         is zero-padded to winLen
+//
+func Spectrogram(signal []float64, winLen, hop int, win []float64) (frames [][]complex128, err error) {
+
+	// check
+	if len(signal) < 1 {
+		return nil, chk.Err("the signal must have at least one sample")
+	}
+	if !utl.IsPowerOfTwo(winLen) {
+		return nil, chk.Err("the window length must be power of 2. winLen = %d is invalid", winLen)
+	}
+	if hop < 1 {
+		return nil, chk.Err("the hop size must be at least 1. hop = %d is invalid", hop)
+	}
+	if win != nil && len(win) != winLen {
+		return nil, chk.Err("the number of window coefficients must be equal to winLen = %d. len(win) = %d is invalid", winLen, len(win))
+	}
+
+	// number of frames
+	nframes := 1
+	if len(signal) > winLen {
+		nframes += (len(signal) - winLen + hop - 1) / hop
+	}
+
+	// compute frames
+	frames = make([][]complex128, nframes)
+	segment := make([]float64, winLen)
+	for i := 0; i < nframes; i++ {
+		start := i * hop
+		for j := 0; j < winLen; j++ {
+			segment[j] = 0
+			if start+j < len(signal) {
+				segment[j] = signal[start+j]
+				if win != nil {
+					segment[j] *= win[j]
+				}
+			}
+		}
+		frames[i], err = RealFourierTrans(segment)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Periodogram estimates the one-sided power spectral density (PSD) of x
+//
+//   x   -- real signal with N samples; N must be an integer power of 2
+//   fs  -- sampling frequency (e.g. in Hz)
+//   win -- window coefficients with len(win) = N (see Window); use nil for the rectangular window
+//
+//   Output:
+//     freqs -- N/2+1 frequencies of the bins; see RfftFreq
+//     psd   -- N/2+1 values of the one-sided PSD (e.g. in units²/Hz):
+//
+//                         c[k]  |X[k]|²                      __
+//              psd[k] = ——————————————     with   S = fs ⋅  \  w[j]²
+//                             S                             /_
+//
+//              where X is the spectrum of the windowed signal and c[k] = 2 for all bins but the
+//              DC and Nyquist ones (c[0] = c[N/2] = 1); i.e. the energy of the negative
+//              frequencies is added to the positive ones
+//
+//   NOTE: with the rectangular window, Parseval's theorem gives Σ psd[k] ⋅ Δf = mean(x²)
+//         where Δf = fs / N
+//
+func Periodogram(x []float64, fs float64, win []float64) (freqs, psd []float64, err error) {
+
+	// check
+	N := len(x)
+	if !utl.IsPowerOfTwo(N) {
+		return nil, nil, chk.Err("the length of x must be power of 2. N = %d is invalid", N)
+	}
+	if fs <= 0 {
+		return nil, nil, chk.Err("the sampling frequency must be positive. fs = %g is invalid", fs)
+	}
+	if win != nil && len(win) != N {
+		return nil, nil, chk.Err("the number of window coefficients must be equal to N = %d. len(win) = %d is invalid", N, len(win))
+	}
+
+	// windowed signal
+	y := make([]float64, N)
+	sumw2 := 0.0
+	for j := 0; j < N; j++ {
+		y[j] = x[j]
+		if win != nil {
+			y[j] *= win[j]
+			sumw2 += win[j] * win[j]
+		}
+	}
+	if win == nil {
+		sumw2 = float64(N)
+	}
+
+	// spectrum
+	X, err := RealFourierTrans(y)
+	if err != nil {
+		return
+	}
+
+	// one-sided PSD
+	freqs = RfftFreq(N, 1.0/fs)
+	psd = make([]float64, len(X))
+	scale := 1.0 / (fs * sumw2)
+	for k := 0; k < len(X); k++ {
+		psd[k] = scale * (real(X[k])*real(X[k]) + imag(X[k])*imag(X[k]))
+		if k > 0 && k < N/2 {
+			psd[k] *= 2
+		}
+	}
+	return
+}
+
+// Welch estimates the one-sided power spectral density (PSD) of x by means of Welch's method;
+// i.e. by averaging the periodograms of overlapping windowed segments of x
+//
+//   x       -- real signal with len(x) ≥ segLen samples
+//   fs      -- sampling frequency (e.g. in Hz)
+//   segLen  -- number of samples in each segment; must be an integer power of 2
+//   overlap -- number of samples shared by consecutive segments; 0 ≤ overlap < segLen
+//   win     -- window coefficients with len(win) = segLen (see Window); use nil for the
+//              rectangular window
+//
+//   Output:
+//     freqs -- segLen/2+1 frequencies of the bins; see RfftFreq
+//     psd   -- segLen/2+1 values of the averaged one-sided PSD; see Periodogram
+//
+//   NOTE: the segments start at j = s ⋅ (segLen - overlap) for s = 0, 1, ..., nseg-1 where
+//         nseg = 1 + (len(x) - segLen) / (segLen - overlap); the trailing samples that do not
+//         fill a whole segment are ignored. The variance of the estimate decreases with nseg
+//         at the cost of a coarser frequency resolution Δf = fs / segLen
+//
+func Welch(x []float64, fs float64, segLen, overlap int, win []float64) (freqs, psd []float64, err error) {
+
+	pxx, freqs, err := welchAverage(x, nil, fs, segLen, overlap, win)
+	if err != nil {
+		return
+	}
+	psd = make([]float64, len(pxx))
+	for k, v := range pxx {
+		psd[k] = real(v)
+	}
+	return
+}
+
+// Coherence estimates the magnitude-squared coherence between the signals x and y
+//
+//                       |Pxy(f)|²
+//            C(f) = ————————————————      ∈ [0, 1]
+//                    Pxx(f) ⋅ Pyy(f)
+//
+//   where the auto-spectra Pxx and Pyy and the cross-spectrum Pxy are averaged over overlapping
+//   segments as in Welch's method, using the Hann window
+//
+//   x, y    -- real signals with len(x) = len(y) ≥ segLen samples
+//   fs      -- sampling frequency (e.g. in Hz)
+//   segLen  -- number of samples in each segment; must be an integer power of 2
+//   overlap -- number of samples shared by consecutive segments; 0 ≤ overlap < segLen
+//
+//   Output:
+//     freqs -- segLen/2+1 frequencies of the bins; see RfftFreq
+//     coh   -- segLen/2+1 values of the coherence
+//
+//   NOTE: (1) C(f) = 1 if y is the output of a linear system with input x and C(f) ≈ 0 if x and
+//             y are unrelated at the frequency f. Since the estimate of a single segment is
+//             always 1, many segments are required; the bias for unrelated signals is ≈ 1/nseg
+//         (2) C(f) = 0 is returned if Pxx(f) or Pyy(f) is zero
+//
+func Coherence(x, y []float64, fs float64, segLen, overlap int) (freqs, coh []float64, err error) {
+	if len(x) != len(y) {
+		return nil, nil, chk.Err("the lengths of x and y must be equal. %d != %d", len(x), len(y))
+	}
+	win, err := Window("hann", segLen)
+	if err != nil {
+		return
+	}
+	pxy, freqs, err := welchAverage(x, y, fs, segLen, overlap, win)
+	if err != nil {
+		return
+	}
+	pxx, _, _ := welchAverage(x, nil, fs, segLen, overlap, win)
+	pyy, _, _ := welchAverage(y, nil, fs, segLen, overlap, win)
+	coh = make([]float64, len(pxy))
+	for k := range pxy {
+		den := real(pxx[k]) * real(pyy[k])
+		if den > 0 {
+			num := real(pxy[k])*real(pxy[k]) + imag(pxy[k])*imag(pxy[k])
+			coh[k] = utl.Min(num/den, 1) // Cauchy-Schwarz: C ≤ 1 apart from round-off
+		}
+	}
+	return
+}
+
+// TransferFunction estimates the frequency response H(f) of a linear system from its input and
+// output signals (H1 estimator)
+//
+//                  Pxy(f)
+//          H(f) = ————————
+//                  Pxx(f)
+//
+//   where x = input and y = output; the input auto-spectrum Pxx and the cross-spectrum Pxy are
+//   averaged over overlapping segments as in Welch's method, using the Hann window
+//
+//   input, output -- real signals with len(input) = len(output) ≥ segLen samples
+//   fs            -- sampling frequency (e.g. in Hz)
+//   segLen        -- number of samples in each segment; must be an integer power of 2
+//   overlap       -- number of samples shared by consecutive segments; 0 ≤ overlap < segLen
+//
+//   Output:
+//     freqs -- segLen/2+1 frequencies of the bins; see RfftFreq
+//     h     -- segLen/2+1 complex values of the frequency response; h = 0 where Pxx = 0
+//
+//   NOTE: noise in the output is averaged out; noise in the input biases |H| downwards
+//
+func TransferFunction(input, output []float64, fs float64, segLen, overlap int) (freqs []float64, h []complex128, err error) {
+	if len(input) != len(output) {
+		return nil, nil, chk.Err("the lengths of input and output must be equal. %d != %d", len(input), len(output))
+	}
+	win, err := Window("hann", segLen)
+	if err != nil {
+		return
+	}
+	pxy, freqs, err := welchAverage(input, output, fs, segLen, overlap, win)
+	if err != nil {
+		return
+	}
+	pxx, _, _ := welchAverage(input, nil, fs, segLen, overlap, win)
+	h = make([]complex128, len(pxy))
+	for k := range pxy {
+		if real(pxx[k]) > 0 {
+			h[k] = pxy[k] / complex(real(pxx[k]), 0)
+		}
+	}
+	return
+}
+
+// welchAverage computes the one-sided cross-spectral density of x and y averaged over segments
+// as in Welch's method; see Welch. With the spectra X and Y of the windowed segments:
+//
+//                      c[k]  conj(X[k]) ⋅ Y[k]
+//          Pxy[k] = mean ( ————————————————————— )     (c[k] and S as in Periodogram)
+//                               S
+//
+//   NOTE: if y is nil, the auto-spectral density of x is computed (real values)
+//
+func welchAverage(x, y []float64, fs float64, segLen, overlap int, win []float64) (pxy []complex128, freqs []float64, err error) {
+
+	// check
+	if !utl.IsPowerOfTwo(segLen) {
+		return nil, nil, chk.Err("the length of segments must be power of 2. segLen = %d is invalid", segLen)
+	}
+	if overlap < 0 || overlap >= segLen {
+		return nil, nil, chk.Err("the overlap must satisfy 0 ≤ overlap < segLen = %d. overlap = %d is invalid", segLen, overlap)
+	}
+	if len(x) < segLen {
+		return nil, nil, chk.Err("the length of x must be greater than or equal to segLen = %d. len(x) = %d is invalid", segLen, len(x))
+	}
+	if fs <= 0 {
+		return nil, nil, chk.Err("the sampling frequency must be positive. fs = %g is invalid", fs)
+	}
+	if win != nil && len(win) != segLen {
+		return nil, nil, chk.Err("the number of window coefficients must be equal to segLen = %d. len(win) = %d is invalid", segLen, len(win))
+	}
+
+	// scaling
+	sumw2 := float64(segLen)
+	if win != nil {
+		sumw2 = 0
+		for _, w := range win {
+			sumw2 += w * w
+		}
+	}
+
+	// average over segments
+	step := segLen - overlap
+	nseg := 1 + (len(x)-segLen)/step
+	scale := 1.0 / (fs * sumw2 * float64(nseg))
+	freqs = RfftFreq(segLen, 1.0/fs)
+	pxy = make([]complex128, segLen/2+1)
+	for s := 0; s < nseg; s++ {
+		start := s * step
+		X, e := windowedSpectrum(x[start:start+segLen], win)
+		if e != nil {
+			return nil, nil, e
+		}
+		Y := X
+		if y != nil {
+			Y, e = windowedSpectrum(y[start:start+segLen], win)
+			if e != nil {
+				return nil, nil, e
+			}
+		}
+		for k := range pxy {
+			c := scale
+			if k > 0 && k < segLen/2 {
+				c *= 2
+			}
+			pxy[k] += complex(c, 0) * complex(real(X[k]), -imag(X[k])) * Y[k]
+		}
+	}
+	return
+}
+
+// windowedSpectrum returns the half spectrum of the segment multiplied by the window (nil means
+// rectangular window); the segment is not modified
+func windowedSpectrum(segment, win []float64) (X []complex128, err error) {
+	v := make([]float64, len(segment))
+	for j := range segment {
+		v[j] = segment[j]
+		if win != nil {
+			v[j] *= win[j]
+		}
+	}
+	return RealFourierTrans(v)
+}
+
+// Detrend removes the trend of x; e.g. before windowing and computing the spectrum
+//
+//   kind -- "constant": subtracts the mean of x
+//           "linear":   subtracts the least-squares fit line a + b⋅j (j is the sample index)
+//
+//   NOTE: a new array is returned; x is not modified
+//
+func Detrend(x []float64, kind string) (y []float64) {
+	n := len(x)
+	y = make([]float64, n)
+	if n == 0 {
+		return
+	}
+	switch kind {
+	case "constant":
+		mean := 0.0
+		for _, v := range x {
+			mean += v
+		}
+		mean /= float64(n)
+		for j, v := range x {
+			y[j] = v - mean
+		}
+	case "linear":
+		if n == 1 {
+			return // a single point lies on the fit line
+		}
+		var sj, sx, sjj, sjx float64
+		for j, v := range x {
+			t := float64(j)
+			sj += t
+			sx += v
+			sjj += t * t
+			sjx += t * v
+		}
+		N := float64(n)
+		b := (N*sjx - sj*sx) / (N*sjj - sj*sj)
+		a := (sx - b*sj) / N
+		for j, v := range x {
+			y[j] = v - (a + b*float64(j))
+		}
+	default:
+		chk.Panic("kind of detrending %q is not available. options: \"constant\", \"linear\"", kind)
+	}
+	return
+}
@@ -0,0 +1,102 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// SpectralDerivative computes the derivative of a smooth periodic function by means of the FFT
+//
+//   x     -- values x[j] = f(j L / N) of the function at N equally spaced points on [0, L);
+//            N must be an integer power of 2
+//   L     -- length (period) of the domain
+//   order -- order of the derivative; order ≥ 0
+//
+//   Output:
+//     dx -- values of the derivative at the same points
+//
+//   NOTE: (1) each bin X[m] of the spectrum is multiplied by (i k[m])^order where k[m] = 2 π m / L
+//         (2) for odd orders, the Nyquist bin is set to zero to keep the result real
+//         (3) the error decays faster than any power of 1/N for smooth periodic functions
+//
+func SpectralDerivative(x []float64, L float64, order int) (dx []float64, err error) {
+
+	// check
+	N := len(x)
+	if order < 0 {
+		return nil, chk.Err("the order of the derivative must be non-negative. order = %d is invalid", order)
+	}
+	if L <= 0 {
+		return nil, chk.Err("the length of the domain must be positive. L = %g is invalid", L)
+	}
+
+	// spectrum
+	X, err := RealFourierTrans(x)
+	if err != nil {
+		return
+	}
+
+	// multiply by (i k)^order
+	ipow := []complex128{1, 1i, -1, -1i}[order%4]
+	for m := 0; m < len(X); m++ {
+		if m == N/2 && order%2 == 1 {
+			X[m] = 0
+			continue
+		}
+		k := 2.0 * math.Pi * float64(m) / L
+		X[m] *= ipow * complex(math.Pow(k, float64(order)), 0)
+	}
+	return RealFourierTransInv(X, N)
+}
+
+// PoissonFFT1d solves the Poisson equation u'' = f with periodic boundary conditions by means of
+// the FFT
+//
+//   f -- values f[j] = f(j L / N) of the right-hand side at N equally spaced points on [0, L);
+//        N must be an integer power of 2 and f must have zero mean
+//   L -- length (period) of the domain
+//
+//   Output:
+//     u -- values of the solution at the same points, with zero mean
+//
+//   NOTE: (1) the spectrum of f is divided by -k[m]² where k[m] = 2 π m / L; the zero mode is
+//             set to zero since the solution is unique up to a constant
+//         (2) the problem has no periodic solution if the mean of f is not zero
+//
+func PoissonFFT1d(f []float64, L float64) (u []float64, err error) {
+
+	// check
+	N := len(f)
+	if L <= 0 {
+		return nil, chk.Err("the length of the domain must be positive. L = %g is invalid", L)
+	}
+
+	// spectrum
+	F, err := RealFourierTrans(f)
+	if err != nil {
+		return
+	}
+
+	// check compatibility: F[0] = N ⋅ mean(f)
+	fmax := 0.0
+	for _, v := range f {
+		fmax = math.Max(fmax, math.Abs(v))
+	}
+	mean := real(F[0]) / float64(N)
+	if math.Abs(mean) > 1e-12*math.Max(fmax, 1) {
+		return nil, chk.Err("the right-hand side f must have zero mean for the periodic problem to be solvable. mean(f) = %g is invalid", mean)
+	}
+
+	// solve in the frequency domain
+	F[0] = 0
+	for m := 1; m < len(F); m++ {
+		k := 2.0 * math.Pi * float64(m) / L
+		F[m] /= complex(-k*k, 0)
+	}
+	return RealFourierTransInv(F, N)
+}
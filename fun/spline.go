@@ -0,0 +1,165 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"sort"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// CubicSpline implements the interpolating cubic spline; i.e. a piecewise cubic polynomial with
+// continuous first and second derivatives at the knots
+type CubicSpline struct {
+	X  []float64 // knots (strictly increasing)
+	Y  []float64 // values at knots
+	M  []float64 // second derivatives at knots
+	Bc string    // boundary condition: "natural" or "clamped"
+}
+
+// NewCubicSpline creates a new cubic spline passing through the points (xs[i], ys[i])
+//
+//   Input:
+//     xs -- strictly increasing knots; len(xs) ≥ 2
+//     ys -- values at knots; len(ys) = len(xs)
+//     bc -- boundary condition:
+//             "natural" : zero second derivatives at both ends
+//             "clamped" : prescribed first derivatives at both ends
+//     slopes -- [optional] the first derivatives at the left and right ends when bc = "clamped".
+//               If not given, zero slopes are used
+//
+//   NOTE: (1) the tridiagonal system for the second derivatives is solved with the Thomas
+//             algorithm in O(n) operations
+//         (2) xs and ys are copied
+//
+func NewCubicSpline(xs, ys []float64, bc string, slopes ...float64) (o *CubicSpline, err error) {
+
+	// check
+	n := len(xs)
+	if len(ys) != n {
+		return nil, chk.Err("lengths of data sets must be the same. %d != %d", n, len(ys))
+	}
+	if n < 2 {
+		return nil, chk.Err("at least 2 knots are required. len(xs) = %d is invalid", n)
+	}
+	for i := 1; i < n; i++ {
+		if xs[i] <= xs[i-1] {
+			return nil, chk.Err("knots must be strictly increasing. xs[%d] = %g ≤ xs[%d] = %g", i, xs[i], i-1, xs[i-1])
+		}
+	}
+	var d0, dn float64
+	switch bc {
+	case "natural":
+		if len(slopes) != 0 {
+			return nil, chk.Err("slopes cannot be given with the natural boundary condition")
+		}
+	case "clamped":
+		switch len(slopes) {
+		case 0:
+		case 2:
+			d0, dn = slopes[0], slopes[1]
+		default:
+			return nil, chk.Err("the clamped boundary condition requires 2 slopes. %d is invalid", len(slopes))
+		}
+	default:
+		return nil, chk.Err("boundary condition %q is invalid. Options are \"natural\" or \"clamped\"", bc)
+	}
+
+	// new spline
+	o = new(CubicSpline)
+	o.X = make([]float64, n)
+	o.Y = make([]float64, n)
+	o.M = make([]float64, n)
+	o.Bc = bc
+	copy(o.X, xs)
+	copy(o.Y, ys)
+
+	// tridiagonal system: a[i]⋅M[i-1] + b[i]⋅M[i] + c[i]⋅M[i+1] = r[i]
+	a := make([]float64, n)
+	b := make([]float64, n)
+	c := make([]float64, n)
+	r := make([]float64, n)
+	for i := 1; i < n-1; i++ {
+		hl, hr := xs[i]-xs[i-1], xs[i+1]-xs[i]
+		a[i] = hl
+		b[i] = 2 * (hl + hr)
+		c[i] = hr
+		r[i] = 6 * ((ys[i+1]-ys[i])/hr - (ys[i]-ys[i-1])/hl)
+	}
+	h0, hn := xs[1]-xs[0], xs[n-1]-xs[n-2]
+	if bc == "natural" {
+		b[0], b[n-1] = 1, 1
+	} else {
+		b[0], c[0] = 2*h0, h0
+		r[0] = 6 * ((ys[1]-ys[0])/h0 - d0)
+		a[n-1], b[n-1] = hn, 2*hn
+		r[n-1] = 6 * (dn - (ys[n-1]-ys[n-2])/hn)
+	}
+
+	// Thomas algorithm
+	for i := 1; i < n; i++ {
+		w := a[i] / b[i-1]
+		b[i] -= w * c[i-1]
+		r[i] -= w * r[i-1]
+	}
+	o.M[n-1] = r[n-1] / b[n-1]
+	for i := n - 2; i >= 0; i-- {
+		o.M[i] = (r[i] - c[i]*o.M[i+1]) / b[i]
+	}
+	return
+}
+
+// Eval evaluates the spline at x
+//
+//   NOTE: outside [X[0], X[n-1]] the end polynomials are used for extrapolation
+//
+func (o *CubicSpline) Eval(x float64) float64 {
+	return o.evalSegment(o.segment(x), x, 0)
+}
+
+// Deriv evaluates the derivative of the spline at x
+//
+//   order -- order of the derivative ≥ 0; order = 0 is equivalent to Eval and the derivatives
+//            of order > 3 are zero
+//
+func (o *CubicSpline) Deriv(x float64, order int) float64 {
+	if order < 0 {
+		chk.Panic("the order of the derivative must be non-negative. order = %d is invalid\n", order)
+	}
+	return o.evalSegment(o.segment(x), x, order)
+}
+
+// segment returns the index i of the segment [X[i], X[i+1]] containing x
+func (o *CubicSpline) segment(x float64) int {
+	i := sort.SearchFloat64s(o.X, x) - 1
+	if i < 0 {
+		return 0
+	}
+	if i > len(o.X)-2 {
+		return len(o.X) - 2
+	}
+	return i
+}
+
+// evalSegment evaluates the derivative of given order of the cubic polynomial of segment i
+func (o *CubicSpline) evalSegment(i int, x float64, order int) float64 {
+	h := o.X[i+1] - o.X[i]
+	t := x - o.X[i]
+	c0 := o.Y[i]
+	c1 := (o.Y[i+1]-o.Y[i])/h - h*(2*o.M[i]+o.M[i+1])/6
+	c2 := o.M[i] / 2
+	c3 := (o.M[i+1] - o.M[i]) / (6 * h)
+	switch order {
+	case 0:
+		return c0 + t*(c1+t*(c2+t*c3))
+	case 1:
+		return c1 + t*(2*c2+t*3*c3)
+	case 2:
+		return 2*c2 + 6*c3*t
+	case 3:
+		return 6 * c3
+	}
+	return 0
+}
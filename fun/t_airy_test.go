@@ -0,0 +1,65 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestAiry01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Airy01. Airy functions Ai, Bi and derivatives")
+
+	// values at zero
+	ai, bi, aip, bip := Airy(0)
+	chk.Float64(tst, "Ai(0)", 1e-15, ai, math.Pow(3, -2.0/3.0)/Gamma(2.0/3.0))
+	chk.Float64(tst, "Bi(0)", 1e-15, bi, math.Pow(3, -1.0/6.0)/Gamma(2.0/3.0))
+	chk.Float64(tst, "Ai'(0)", 1e-15, aip, -math.Pow(3, -1.0/3.0)/Gamma(1.0/3.0))
+	chk.Float64(tst, "Bi'(0)", 1e-15, bip, math.Pow(3, 1.0/6.0)/Gamma(1.0/3.0))
+
+	// reference values computed with the Maclaurin series in high-precision arithmetic
+	//   x, Ai, Bi, Ai', Bi'
+	ref := [][]float64{
+		{0.5, 2.31693606480833481e-01, 8.54277043103155442e-01, -2.24910532664683888e-01, 5.44572564140592297e-01},
+		{-0.5, 4.75728091610539583e-01, 3.80352659751053868e-01, -2.04081670339547383e-01, 5.05933713623847203e-01},
+		{1.5, 7.17494970081054145e-02, 1.87894150374789493e+00, -9.73820128423013159e-02, 1.88621225484816546e+00},
+		{-1.5, 4.64256577748869415e-01, -1.91784861157041214e-01, 3.09186967202410401e-01, 5.57908103021897306e-01},
+		{2.5, 1.57259233804704912e-02, 6.48166073846057866e+00, -2.62508810359032320e-02, 9.42142331733430183e+00},
+		{-2.5, -1.12325067692966088e-01, -4.32422471840705314e-01, 6.78852734264794311e-01, -2.20420154874629598e-01},
+		{4, 9.51563851204801844e-04, 8.38470714084681390e+01, -1.95864095020417900e-03, 1.61926683504613408e+02},
+		{-4, -7.02655329492895137e-02, 3.92234705706999309e-01, -7.90628575368581332e-01, -1.16670567438340894e-01},
+		{7, 7.49212886399716658e-07, 8.03277907094302500e+04, -2.00815089473879194e-06, 2.09552670873971307e+05},
+		{-7, 1.84280835250505648e-01, 2.93762071854413997e-01, -7.71008168410126538e-01, 4.98244590058113501e-01},
+		{12, 1.39318468887536074e-13, 3.29807225829074158e+11, -4.85473655498530890e-13, 1.13550750244337085e+12},
+		{-12, -6.65551750543731252e-02, -2.95719912078073077e-01, 1.02311045336797068e+00, -2.36732197831123314e-01},
+		{20, 1.69167286867054043e-27, 2.10376504965110382e+25, -7.58639162574835447e-27, 9.38183933613396496e+25},
+		{-20, -1.76406127077984698e-01, -2.00139309322651338e-01, 8.92862856736471255e-01, -7.91429033839536444e-01},
+		{-40, -4.59339234379572484e-02, 2.19588624284042411e-01, -1.38909087526071828e+00, -2.89139940282091945e-01},
+	}
+	names := []string{"Ai", "Bi", "Ai'", "Bi'"}
+	for _, r := range ref {
+		x := r[0]
+		ai, bi, aip, bip = Airy(x)
+		for k, v := range []float64{ai, bi, aip, bip} {
+			tol := 1e-13
+			if x < 0 {
+				tol = 1e-13 * math.Abs(x) // the phase of the oscillations is computed from ζ ~ |x|^(3/2)
+			}
+			chk.Float64(tst, io.Sf("%s(%g)/ref", names[k], x), tol, v/r[1+k], 1)
+		}
+	}
+
+	// Wronskian: Ai Bi' - Ai' Bi = 1/π
+	io.Pl()
+	for _, x := range []float64{-30, -10, -5, -2.2, -2.3, -1, 0, 1, 2.2, 2.3, 5, 10} {
+		ai, bi, aip, bip = Airy(x)
+		chk.Float64(tst, io.Sf("W(%g)", x), 1e-13, ai*bip-aip*bi, 1.0/math.Pi)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/plt"
+	"github.com/cpmech/gosl/utl"
+)
+
+func TestAkima01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Akima01. data with one outlier")
+
+	// straight line with an outlier at x = 5
+	line := func(x float64) float64 { return 0.1 * x }
+	xs := utl.LinSpace(0, 10, 11)
+	ys := utl.GetMapped(xs, line)
+	ys[5] += 2
+	o, err := NewAkima(xs, ys)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	s, err := NewCubicSpline(xs, ys, "natural")
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+
+	// interpolation
+	for i, x := range xs {
+		chk.Float64(tst, io.Sf("A(x%d)", i), 1e-15, o.Eval(x), ys[i])
+	}
+
+	// away from the two segments next to the outlier, Akima recovers the line whereas the
+	// natural spline oscillates
+	X := utl.LinSpace(xs[0], xs[len(xs)-1], 1001)
+	A := utl.GetMapped(X, func(x float64) float64 { return o.Eval(x) })
+	S := utl.GetMapped(X, func(x float64) float64 { return s.Eval(x) })
+	errA, errS := 0.0, 0.0
+	for k, x := range X {
+		if x > 4 && x < 6 {
+			continue
+		}
+		errA = math.Max(errA, math.Abs(A[k]-line(x)))
+		errS = math.Max(errS, math.Abs(S[k]-line(x)))
+	}
+	io.Pforan("max deviation from the line away from the outlier: akima = %.3e  spline = %.3e\n", errA, errS)
+	chk.Float64(tst, "akima deviation", 1e-14, errA, 0)
+	if errS < 0.05 {
+		tst.Errorf("the natural cubic spline should have oscillated with this data\n")
+		return
+	}
+
+	// the derivative is continuous at the outlier
+	h := 1e-6
+	dl := (o.Eval(5) - o.Eval(5-h)) / h
+	dr := (o.Eval(5+h) - o.Eval(5)) / h
+	chk.Float64(tst, "A'(5⁻) = A'(5⁺)", 1e-4, dl, dr)
+
+	if chk.Verbose {
+		plt.Reset(true, &plt.A{WidthPt: 400, Dpi: 150})
+		plt.Plot(xs, ys, &plt.A{C: "k", Ls: "none", M: "o", L: "data", NoClip: true})
+		plt.Plot(X, A, &plt.A{C: "r", Ls: "-", L: "akima", NoClip: true})
+		plt.Plot(X, S, &plt.A{C: "b", Ls: "--", L: "natural spline", NoClip: true})
+		plt.Gll("x", "y", nil)
+		plt.HideTRborders()
+		plt.Save("/tmp/gosl/fun", "akima01")
+	}
+}
+
+func TestAkima02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Akima02. linear data and invalid input")
+
+	// straight line is reproduced exactly; also with non-uniform knots and extrapolation
+	xs := []float64{-2, -1.5, 0, 0.1, 1, 3}
+	o, err := NewAkima(xs, utl.GetMapped(xs, func(x float64) float64 { return 1 - 0.5*x }))
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for _, x := range []float64{-2.5, -2, -1.7, 0.05, 2, 3.5} {
+		chk.Float64(tst, io.Sf("A(%5.2f)", x), 1e-15, o.Eval(x), 1-0.5*x)
+	}
+
+	// errors
+	if _, err = NewAkima([]float64{0, 1, 2, 3, 4}, []float64{0, 1, 2, 3}); err == nil {
+		tst.Errorf("different lengths should have caused an error\n")
+		return
+	}
+	if _, err = NewAkima([]float64{0, 1, 2, 3}, []float64{0, 1, 2, 3}); err == nil {
+		tst.Errorf("4 knots should have caused an error\n")
+		return
+	}
+	if _, err = NewAkima([]float64{0, 1, 3, 2, 4}, []float64{0, 1, 2, 3, 4}); err == nil {
+		tst.Errorf("non-increasing knots should have caused an error\n")
+		return
+	}
+}
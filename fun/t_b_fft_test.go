@@ -0,0 +1,138 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"testing"
+
+	"github.com/cpmech/gosl/io"
+)
+
+var (
+	benchFFTdata []float64
+)
+
+func BenchmarkFourierTransLL(b *testing.B) {
+	benchFFTdata = fftPack(fftTestData(1024))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FourierTransLL(benchFFTdata, i%2 == 1)
+	}
+}
+
+func BenchmarkFourierPlan(b *testing.B) {
+	benchFFTdata = fftPack(fftTestData(1024))
+	plan, _ := NewFourierPlan(1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		plan.Transform(benchFFTdata, i%2 == 1)
+	}
+}
+
+func BenchmarkBatchDftSequential(b *testing.B) {
+	rows := fftBatchTestData(256, 1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, row := range rows {
+			FourierTransLL(row, i%2 == 1)
+		}
+	}
+}
+
+func BenchmarkBatchDft(b *testing.B) {
+	rows := fftBatchTestData(256, 1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BatchDft(rows, i%2 == 1, 0)
+	}
+}
+
+func BenchmarkFourierTransFast(b *testing.B) {
+	for _, n := range []int{256, 1024, 4096} {
+		b.Run(io.Sf("LL/N=%d", n), func(b *testing.B) {
+			data := fftPack(fftTestData(n))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				FourierTransLL(data, i%2 == 1)
+			}
+		})
+		b.Run(io.Sf("Fast/N=%d", n), func(b *testing.B) {
+			data := fftPack(fftTestData(n))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				FourierTransFast(data, i%2 == 1)
+			}
+		})
+	}
+}
+
+func BenchmarkFourierTransSplitRadix(b *testing.B) {
+	for _, n := range []int{256, 1024, 4096} {
+		b.Run(io.Sf("LL/N=%d", n), func(b *testing.B) {
+			data := fftPack(fftTestData(n))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				FourierTransLL(data, i%2 == 1)
+			}
+		})
+		b.Run(io.Sf("SplitRadix/N=%d", n), func(b *testing.B) {
+			data := fftPack(fftTestData(n))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				FourierTransSplitRadix(data, i%2 == 1)
+			}
+		})
+	}
+}
+
+func BenchmarkDftSplit(b *testing.B) {
+	n := 1 << 16
+	x := fftTestData(n)
+	re := make([]float64, n)
+	im := make([]float64, n)
+	for i, v := range x {
+		re[i], im[i] = real(v), imag(v)
+	}
+	b.Run("interleaved", func(b *testing.B) {
+		data := make([]float64, 2*n)
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < n; j++ {
+				data[2*j], data[2*j+1] = re[j], im[j]
+			}
+			FourierTransLL(data, i%2 == 1)
+			for j := 0; j < n; j++ {
+				re[j], im[j] = data[2*j], data[2*j+1]
+			}
+		}
+	})
+	b.Run("split", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			DftSplit(re, im, i%2 == 1)
+		}
+	})
+}
+
+func BenchmarkRealFFT(b *testing.B) {
+	n := 1024
+	x := make([]float64, n)
+	for i, v := range fftTestData(n) {
+		x[i] = real(v)
+	}
+	X := make([]complex128, n/2+1)
+	r := NewRealFFT(n)
+	allocs := testing.AllocsPerRun(10, func() {
+		r.Forward(x, X)
+		r.Inverse(X, x)
+	})
+	if allocs != 0 {
+		b.Fatalf("RealFFT must not allocate memory. allocations per run = %v\n", allocs)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Forward(x, X)
+		r.Inverse(X, x)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+func TestBarycentric01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Barycentric01. degree-10 polynomial at Chebyshev nodes")
+
+	// p(x) = Σ c[k] xᵏ with k = 0...10
+	c := []float64{1, -2, 0.5, 3, -1, 0.25, 2, -0.75, 1.5, -3, 0.8}
+	p := func(x float64) (res float64) {
+		for k := len(c) - 1; k >= 0; k-- {
+			res = res*x + c[k]
+		}
+		return
+	}
+
+	// Chebyshev-Gauss and Chebyshev-Gauss-Lobatto nodes
+	for _, xs := range [][]float64{ChebyshevXgauss(10), ChebyshevXlob(10)} {
+		o := NewBarycentric(xs, utl.GetMapped(xs, p))
+		for _, x := range utl.LinSpace(-1, 1, 41) {
+			chk.Float64(tst, io.Sf("p(%5.2f)", x), 1e-13, o.Eval(x), p(x))
+		}
+
+		// at nodes (no division by zero)
+		for i, x := range xs {
+			v := o.Eval(x)
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				tst.Errorf("Eval at node %d is not finite\n", i)
+				return
+			}
+			chk.Float64(tst, io.Sf("p(x%d)", i), 1e-17, v, o.Y[i])
+		}
+		io.Pl()
+	}
+}
+
+func TestBarycentric02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Barycentric02. unordered nodes and high degree")
+
+	// unordered nodes
+	xs := []float64{2, -1, 0.5, 3, 0}
+	o := NewBarycentric(xs, utl.GetMapped(xs, func(x float64) float64 { return x*x - x + 1 }))
+	for _, x := range []float64{-2, -0.3, 1, 2.5, 4} {
+		chk.Float64(tst, io.Sf("p(%g)", x), 1e-13, o.Eval(x), x*x-x+1)
+	}
+
+	// high degree: Runge function at 201 Chebyshev-Gauss-Lobatto nodes
+	f := func(x float64) float64 { return 1 / (1 + 25*x*x) }
+	xs = ChebyshevXlob(200)
+	o = NewBarycentric(xs, utl.GetMapped(xs, f))
+	maxErr := 0.0
+	for _, x := range utl.LinSpace(-1, 1, 1001) {
+		maxErr = math.Max(maxErr, math.Abs(o.Eval(x)-f(x)))
+	}
+	io.Pforan("max error (N=200) = %v\n", maxErr)
+	if maxErr > 1e-14 {
+		tst.Errorf("max error is too large: %g\n", maxErr)
+		return
+	}
+
+	// single node
+	o = NewBarycentric([]float64{1}, []float64{7})
+	chk.Float64(tst, "constant", 1e-17, o.Eval(5), 7)
+}
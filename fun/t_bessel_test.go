@@ -221,3 +221,110 @@ func TestBessel02(tst *testing.T) {
 		}
 	}
 }
+
+func TestBessel03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Bessel03. BesselJ and BesselY with integer order")
+
+	// reference values from [1] (Tables 9.1 and 9.2)
+	for _, c := range []struct {
+		n       int
+		x, J, Y float64
+	}{
+		{0, 1, 7.651976865579666e-01, 8.825696421567696e-02},
+		{1, 0.5, 2.422684576748739e-01, -1.471472392670243e+00},
+		{2, 1, 1.149034849319005e-01, -1.650682606816254e+00},
+		{5, 1, 2.497577302112345e-04, -2.604058666258122e+02},
+		{3, 2.5, 2.166003910391136e-01, -7.560554967536708e-01},
+		{7, 3, 2.547294451804693e-03, -1.983993540898642e+01},
+		{4, 5, 3.912323604586482e-01, -1.921422873736932e-01},
+		{5, 10, -2.340615281867937e-01, 1.354030476893623e-01},
+		{10, 10, 2.074861066333590e-01, -3.598141521834028e-01},
+		{20, 10, 1.151336924781340e-05, -1.597483848269626e+03},
+	} {
+		J, Y := BesselJ(c.n, c.x), BesselY(c.n, c.x)
+		chk.Float64(tst, io.Sf("J%-2d(%4g)=%23.15e", c.n, c.x, J), 1e-14*math.Max(1, math.Abs(c.J)), J, c.J)
+		chk.Float64(tst, io.Sf("Y%-2d(%4g)=%23.15e", c.n, c.x, Y), 1e-13*math.Max(1, math.Abs(c.Y)), Y, c.Y)
+	}
+
+	// compare with math.Jn and math.Yn
+	io.Pl()
+	for n := -6; n <= 30; n++ {
+		for _, x := range []float64{0.1, 0.75, 1.5, 3, 7.5, 12, 25, 40} {
+			chk.AnaNum(tst, io.Sf("J%d(%g)", n, x), 1e-13, BesselJ(n, x), math.Jn(n, x), false)
+			chk.AnaNum(tst, io.Sf("J%d(%g)", n, -x), 1e-13, BesselJ(n, -x), math.Jn(n, -x), false)
+			y := BesselY(n, x)
+			chk.AnaNum(tst, io.Sf("Y%d(%g)", n, x), 1e-13*math.Max(1, math.Abs(y)), y, math.Yn(n, x), false)
+		}
+	}
+
+	// special cases
+	chk.Float64(tst, "J0(0)", 1e-17, BesselJ(0, 0), 1)
+	chk.Float64(tst, "J3(0)", 1e-17, BesselJ(3, 0), 0)
+	if !math.IsInf(BesselY(0, 0), -1) || !math.IsInf(BesselY(4, 0), -1) {
+		tst.Errorf("Yn(0) should be -Inf\n")
+		return
+	}
+	if !math.IsInf(BesselY(-3, 0), +1) {
+		tst.Errorf("Y-3(0) should be +Inf\n")
+		return
+	}
+	if !math.IsNaN(BesselY(2, -1)) {
+		tst.Errorf("Y2(-1) should be NaN\n")
+		return
+	}
+}
+
+func TestBessel04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Bessel04. BesselI and BesselK with integer order")
+
+	// reference values computed with 50-digit series (I) and quadrature of
+	// Kn(x) = ∫ exp(-x cosh t) cosh(n t) dt (K); see also [1] (Tables 9.8 and 9.9)
+	for _, c := range []struct {
+		n       int
+		x, I, K float64
+	}{
+		{0, 1, 1.266065877752008e+00, 4.210244382407084e-01},
+		{1, 1, 5.651591039924850e-01, 6.019072301972346e-01},
+		{2, 2, 6.889484476987382e-01, 2.537597545660563e-01},
+		{3, 0.5, 2.645111968990286e-03, 6.205790952993524e+01},
+		{10, 5, 4.580044419176052e-03, 9.758562829177814e+00},
+		{5, 10, 7.771882864032600e+02, 5.754184998531217e-05},
+		{0, 60, 5.894077055609801e+24, 1.413897840559106e-27},
+		{2, 60, 5.699252002663452e+24, 1.461418908109679e-27},
+		{3, 80, 2.338975233825292e+33, 2.670285186055841e-36},
+		{1, 100, 1.068369390338163e+42, 4.679853735636906e-45},
+	} {
+		I, K := BesselI(c.n, c.x), BesselK(c.n, c.x)
+		chk.Float64(tst, io.Sf("I%-2d(%4g)=%23.15e", c.n, c.x, I), 1e-13*math.Abs(c.I), I, c.I)
+		chk.Float64(tst, io.Sf("K%-2d(%4g)=%23.15e", c.n, c.x, K), 1e-13*math.Abs(c.K), K, c.K)
+	}
+
+	// Wronskian: In(x) Kn+1(x) + In+1(x) Kn(x) = 1/x
+	io.Pl()
+	for n := 0; n <= 12; n++ {
+		for _, x := range []float64{0.1, 0.5, 1, 2, 3.5, 10, 30, 55, 200} {
+			w := BesselI(n, x)*BesselK(n+1, x) + BesselI(n+1, x)*BesselK(n, x)
+			chk.AnaNum(tst, io.Sf("n=%2d x=%5g: x⋅W", n, x), 1e-12, x*w, 1, false)
+		}
+	}
+
+	// symmetries and special cases
+	chk.Float64(tst, "I-3(1.5) = I3(1.5)", 1e-17, BesselI(-3, 1.5), BesselI(3, 1.5))
+	chk.Float64(tst, "I3(-1.5) = -I3(1.5)", 1e-17, BesselI(3, -1.5), -BesselI(3, 1.5))
+	chk.Float64(tst, "I2(-7) = I2(7)", 1e-17, BesselI(2, -7), BesselI(2, 7))
+	chk.Float64(tst, "K-2(3) = K2(3)", 1e-17, BesselK(-2, 3), BesselK(2, 3))
+	chk.Float64(tst, "I0(0)", 1e-17, BesselI(0, 0), 1)
+	chk.Float64(tst, "I4(0)", 1e-17, BesselI(4, 0), 0)
+	if !math.IsInf(BesselK(1, 0), +1) {
+		tst.Errorf("K1(0) should be +Inf\n")
+		return
+	}
+	if !math.IsNaN(BesselK(0, -1)) {
+		tst.Errorf("K0(-1) should be NaN\n")
+		return
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestDftAny01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("DftAny01. Bluestein's algorithm with any N")
+
+	for _, N := range []int{1, 2, 3, 5, 6, 7, 8, 10, 100} {
+
+		// forward
+		x := fftTestData(N)
+		X, err := DftAny(x, false)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.ArrayC(tst, io.Sf("N=%3d: DftAny(x) = DFT(x)", N), 1e-11, X, dft1dslow(x))
+
+		// inverse
+		y, err := DftAny(X, true)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.ArrayC(tst, io.Sf("N=%3d: DftAny(X, inverse) = x", N), 1e-12, y, x)
+	}
+
+	// error
+	if _, err := DftAny(nil, false); err == nil {
+		tst.Errorf("N=0 should have caused an error\n")
+		return
+	}
+}
+
+func TestChirpZ01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ChirpZ01. chirp z-transform reproduces the DFT")
+
+	for _, N := range []int{1, 2, 5, 8, 12, 33} {
+		x := fftTestData(N)
+		X, err := ChirpZ(x, N, ExpMix(2*math.Pi/float64(N)), 1)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.ArrayC(tst, io.Sf("N=%2d: ChirpZ(x) = DFT(x)", N), 1e-11, X, dft1dslow(x))
+	}
+
+	// errors
+	x := fftTestData(4)
+	if _, err := ChirpZ(nil, 4, 1, 1); err == nil {
+		tst.Errorf("N=0 should have caused an error\n")
+	}
+	if _, err := ChirpZ(x, 0, 1, 1); err == nil {
+		tst.Errorf("m=0 should have caused an error\n")
+	}
+	if _, err := ChirpZ(x, 4, 0, 1); err == nil {
+		tst.Errorf("w=0 should have caused an error\n")
+	}
+	if _, err := ChirpZ(x, 4, 1, 0); err == nil {
+		tst.Errorf("a=0 should have caused an error\n")
+	}
+}
+
+func TestChirpZ02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ChirpZ02. zoom FFT over a narrow band")
+
+	// tone between DFT bins, which are spaced by fs/N = 4 Hz
+	N, fs, f0 := 256, 1024.0, 101.3
+	x := make([]complex128, N)
+	for j := 0; j < N; j++ {
+		x[j] = complex(math.Cos(2*math.Pi*f0*float64(j)/fs), 0)
+	}
+	Xdft, err := Dft(x, false)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	fdft := float64(spectralArgmax(Xdft[:N/2])) * fs / float64(N)
+
+	// zoom into [f1, f2) with 0.1 Hz resolution
+	f1, f2, m := 95.0, 107.0, 120
+	a := ExpPix(2 * math.Pi * f1 / fs)
+	w := ExpMix(2 * math.Pi * (f2 - f1) / (float64(m) * fs))
+	X, err := ChirpZ(x, m, w, a)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+
+	// compare with the direct evaluation of the discrete-time Fourier transform
+	ref := make([]complex128, m)
+	for k := 0; k < m; k++ {
+		f := f1 + float64(k)*(f2-f1)/float64(m)
+		for j := 0; j < N; j++ {
+			ref[k] += x[j] * ExpMix(2*math.Pi*f*float64(j)/fs)
+		}
+	}
+	chk.ArrayC(tst, "ChirpZ = DTFT on [f1,f2)", 1e-10, X, ref)
+
+	// the finer grid locates the peak much more accurately
+	fzoom := f1 + float64(spectralArgmax(X))*(f2-f1)/float64(m)
+	io.Pforan("f0 = %g, peak(DFT) = %g, peak(ChirpZ) = %g\n", f0, fdft, fzoom)
+	chk.Float64(tst, "peak(ChirpZ)", 0.051, fzoom, f0)
+	if math.Abs(fdft-f0) < 1 {
+		tst.Errorf("the DFT peak should be off by more than 1 Hz\n")
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestCepstrum01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Cepstrum01. echo detection")
+
+	// decaying pulse with an echo: x[n] = s[n] + a s[n-d]
+	N, d, a := 1024, 100, 0.5
+	s := make([]float64, N)
+	for n := 0; n < N/2; n++ {
+		s[n] = math.Pow(0.8, float64(n))
+	}
+	x := make([]float64, N)
+	for n := 0; n < N; n++ {
+		x[n] = s[n]
+		if n >= d {
+			x[n] += a * s[n-d]
+		}
+	}
+
+	// peak away from the origin
+	argmax := func(c []float64) (imax int) {
+		imax = 10
+		for n := 10; n < N/2; n++ {
+			if math.Abs(c[n]) > math.Abs(c[imax]) {
+				imax = n
+			}
+		}
+		return
+	}
+
+	// real cepstrum: peak a/2 at n = d and n = N-d
+	c, err := RealCepstrum(x)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	io.Pforan("real cepstrum:    c[d] = %g\n", c[d])
+	chk.Int(tst, "real cepstrum: quefrency of peak", argmax(c), d)
+	chk.Float64(tst, "real cepstrum: c[d]", 1e-10, c[d], a/2)
+	chk.Float64(tst, "real cepstrum: c[N-d]", 1e-10, c[N-d], a/2)
+
+	// complex cepstrum: peaks a, -a²/2 at n = d, 2d
+	c, err = ComplexCepstrum(x)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	io.Pforan("complex cepstrum: c[d] = %g, c[2d] = %g\n", c[d], c[2*d])
+	chk.Int(tst, "complex cepstrum: quefrency of peak", argmax(c), d)
+	chk.Float64(tst, "complex cepstrum: c[d]", 1e-10, c[d], a)
+	chk.Float64(tst, "complex cepstrum: c[2d]", 1e-10, c[2*d], -a*a/2)
+
+	// complex cepstrum of s: c[n] = 0.8ⁿ/n for n ≥ 1
+	c, err = ComplexCepstrum(s)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for n := 1; n < 20; n++ {
+		chk.Float64(tst, io.Sf("c[%2d]", n), 1e-10, c[n], math.Pow(0.8, float64(n))/float64(n))
+	}
+
+	// errors
+	if _, err = RealCepstrum(x[:100]); err == nil {
+		tst.Errorf("N=100 should have caused an error\n")
+	}
+	if _, err = RealCepstrum(make([]float64, 8)); err == nil {
+		tst.Errorf("zero spectrum should have caused an error\n")
+	}
+	if _, err = ComplexCepstrum([]float64{1, -1}); err == nil {
+		tst.Errorf("zero bin should have caused an error\n")
+	}
+}
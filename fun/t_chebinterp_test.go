@@ -0,0 +1,128 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+func TestChebInterp01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ChebInterp01. spectral convergence")
+
+	// smooth function on [0, 2]
+	f := func(x float64) float64 { return math.Exp(x) * math.Sin(3*x) }
+	a, b := 0.0, 2.0
+	X := utl.LinSpace(a, b, 201)
+
+	// max error versus degree
+	degrees := []int{4, 8, 12, 16, 20, 32}
+	errors := make([]float64, len(degrees))
+	for i, N := range degrees {
+		o := NewChebInterp(f, a, b, N)
+		for _, x := range X {
+			errors[i] = math.Max(errors[i], math.Abs(o.Eval(x)-f(x)))
+		}
+		io.Pforan("N = %2d  max error = %v\n", N, errors[i])
+	}
+
+	// exponential decrease (until machine precision is reached): each increment of 4 in the
+	// degree reduces the error by at least 10²
+	for i := 1; i < len(degrees)-1; i++ {
+		if errors[i] > 1e-2*errors[i-1] {
+			tst.Errorf("error did not decrease exponentially: N=%d: %g, N=%d: %g\n", degrees[i-1], errors[i-1], degrees[i], errors[i])
+			return
+		}
+	}
+	if errors[len(errors)-1] > 1e-13 {
+		tst.Errorf("N = 32: error = %g is too large\n", errors[len(errors)-1])
+		return
+	}
+}
+
+func TestChebInterp02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ChebInterp02. nodes, polynomials and integral")
+
+	// interpolation at nodes (FFT and direct sums)
+	f := func(x float64) float64 { return 1 / (1 + x*x) }
+	for _, N := range []int{7, 8, 10, 16} {
+		o := NewChebInterp(f, -3, 1, N)
+		for j := 0; j <= N; j++ {
+			x := -1 + 2*math.Cos(float64(j)*math.Pi/float64(N))
+			chk.Float64(tst, io.Sf("N=%2d: p(x%d)", N, j), 1e-14, o.Eval(x), f(x))
+		}
+	}
+
+	// polynomial of degree 3 is reproduced exactly
+	io.Pl()
+	p := func(x float64) float64 { return 2*x*x*x - x + 0.5 }
+	o := NewChebInterp(p, -1, 3, 3)
+	chk.Array(tst, "coefficients", 1e-13, o.Coef, []float64{13.5, 22, 12, 4})
+	for _, x := range []float64{-1, 0, 0.7, 2, 3} {
+		chk.Float64(tst, io.Sf("p(%g)", x), 1e-13, o.Eval(x), p(x))
+	}
+	chk.Float64(tst, "∫p", 1e-13, o.Integral(), 38)
+
+	// integral of smooth function
+	o = NewChebInterp(math.Exp, 0, 1, 16)
+	chk.Float64(tst, "∫exp(x) in [0,1]", 1e-15, o.Integral(), math.E-1)
+	o = NewChebInterp(math.Sin, 0, math.Pi, 20)
+	chk.Float64(tst, "∫sin(x) in [0,π]", 1e-14, o.Integral(), 2)
+}
+
+func TestChebInterp03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ChebInterp03. derivative from coefficients")
+
+	// derivative of sin on [-2, 5]
+	a, b := -2.0, 5.0
+	o := NewChebInterp(math.Sin, a, b, 32)
+	d := o.Derivative()
+	chk.Int(tst, "degree of derivative", len(d.Coef)-1, 31)
+	maxErr := 0.0
+	for _, x := range utl.LinSpace(a, b, 301) {
+		maxErr = math.Max(maxErr, math.Abs(d.Eval(x)-math.Cos(x)))
+	}
+	io.Pforan("max error of p' = %v\n", maxErr)
+	if maxErr > 1e-12 {
+		tst.Errorf("max error of p' = %g is too large\n", maxErr)
+		return
+	}
+
+	// second derivative
+	d2 := d.Derivative()
+	maxErr = 0.0
+	for _, x := range utl.LinSpace(a, b, 301) {
+		maxErr = math.Max(maxErr, math.Abs(d2.Eval(x)+math.Sin(x)))
+	}
+	io.Pforan("max error of p'' = %v\n", maxErr)
+	if maxErr > 1e-10 {
+		tst.Errorf("max error of p'' = %g is too large\n", maxErr)
+		return
+	}
+
+	// polynomial: p = 2x³ - x + 0.5 => p' = 6x² - 1
+	p := NewChebInterp(func(x float64) float64 { return 2*x*x*x - x + 0.5 }, -1, 3, 3)
+	dp := p.Derivative()
+	for _, x := range []float64{-1, 0, 0.7, 2, 3} {
+		chk.Float64(tst, io.Sf("p'(%g)", x), 1e-13, dp.Eval(x), 6*x*x-1)
+	}
+
+	// derivatives of lower degree polynomials
+	dddp := dp.Derivative().Derivative()
+	chk.Float64(tst, "p'''", 1e-13, dddp.Eval(0.3), 12)
+	zero := dddp.Derivative()
+	chk.Array(tst, "p''''", 1e-17, zero.Coef, []float64{0})
+	chk.Float64(tst, "p''''(1)", 1e-17, zero.Eval(1), 0)
+}
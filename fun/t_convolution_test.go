@@ -0,0 +1,374 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// randomArray returns an array with n random values in [-1, 1)
+func randomArray(rng *rand.Rand, n int) (x []float64) {
+	x = make([]float64, n)
+	for i := 0; i < n; i++ {
+		x[i] = 2*rng.Float64() - 1
+	}
+	return
+}
+
+func TestConvolve01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Convolve01. numpy modes")
+
+	// numpy.convolve([1,2,3], [0,1,0.5], mode)
+	a := []float64{1, 2, 3}
+	b := []float64{0, 1, 0.5}
+	c, _ := Convolve(a, b, "full")
+	chk.Array(tst, "full ", 1e-15, c, []float64{0, 1, 2.5, 4, 1.5})
+	c, _ = Convolve(a, b, "same")
+	chk.Array(tst, "same ", 1e-15, c, []float64{1, 2.5, 4})
+	c, _ = Convolve(a, b, "valid")
+	chk.Array(tst, "valid", 1e-15, c, []float64{2.5})
+
+	// numpy.convolve([1,2,3,4], [1,1], mode)
+	a = []float64{1, 2, 3, 4}
+	b = []float64{1, 1}
+	c, _ = Convolve(a, b, "same")
+	chk.Array(tst, "same ", 1e-15, c, []float64{1, 3, 5, 7})
+	c, _ = Convolve(b, a, "valid")
+	chk.Array(tst, "valid", 1e-15, c, []float64{3, 5, 7})
+
+	// errors
+	if _, err := Convolve(a, b, "wrong"); err == nil {
+		tst.Errorf("unknown mode should have caused an error\n")
+		return
+	}
+	if _, err := Convolve(nil, b, "full"); err == nil {
+		tst.Errorf("empty input should have caused an error\n")
+		return
+	}
+}
+
+func TestConvolve02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Convolve02. FFT versus direct sum")
+
+	rng := rand.New(rand.NewSource(1234))
+	for _, sizes := range [][]int{{1, 1}, {5, 3}, {100, 37}, {33, 257}, {1000, 11}} {
+		a := randomArray(rng, sizes[0])
+		b := randomArray(rng, sizes[1])
+		full := convolveDirect(a, b)
+		nmin, nmax := sizes[0], sizes[1]
+		if nmin > nmax {
+			nmin, nmax = nmax, nmin
+		}
+		c, err := convolveFFT(a, b)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Array(tst, io.Sf("%4d⊛%4d: fft = direct", sizes[0], sizes[1]), 1e-12, c, full)
+		c, _ = Convolve(a, b, "full")
+		chk.Array(tst, io.Sf("%4d⊛%4d: full", sizes[0], sizes[1]), 1e-12, c, full)
+		c, _ = Convolve(a, b, "same")
+		chk.Array(tst, io.Sf("%4d⊛%4d: same", sizes[0], sizes[1]), 1e-12, c, full[(nmin-1)/2:(nmin-1)/2+nmax])
+		c, _ = Convolve(a, b, "valid")
+		chk.Array(tst, io.Sf("%4d⊛%4d: valid", sizes[0], sizes[1]), 1e-12, c, full[nmin-1:nmax])
+	}
+}
+
+func TestCircularConvolve01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("CircularConvolve01. cyclic convolution")
+
+	rng := rand.New(rand.NewSource(4321))
+	for _, N := range []int{1, 2, 8, 64} {
+
+		// explicit modular sum
+		a := randomArray(rng, N)
+		b := randomArray(rng, N)
+		cref := make([]float64, N)
+		for k := 0; k < N; k++ {
+			for j := 0; j < N; j++ {
+				cref[k] += a[j] * b[((k-j)%N+N)%N]
+			}
+		}
+		c, err := CircularConvolve(a, b)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Array(tst, io.Sf("N=%2d: a⊛b", N), 1e-13, c, cref)
+
+		// circular shift: convolving with a shifted unit impulse rotates the signal
+		shift := N / 2
+		δ := make([]float64, N)
+		δ[shift] = 1
+		c, _ = CircularConvolve(a, δ)
+		rotated := make([]float64, N)
+		for k := 0; k < N; k++ {
+			rotated[(k+shift)%N] = a[k]
+		}
+		chk.Array(tst, io.Sf("N=%2d: a⊛δ(shift)", N), 1e-14, c, rotated)
+	}
+
+	// errors
+	if _, err := CircularConvolve(make([]float64, 4), make([]float64, 8)); err == nil {
+		tst.Errorf("different lengths should have caused an error\n")
+		return
+	}
+	if _, err := CircularConvolve(make([]float64, 6), make([]float64, 6)); err == nil {
+		tst.Errorf("N=6 should have caused an error\n")
+		return
+	}
+}
+
+func TestCorrelate01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Correlate01. cross-correlation and autocorrelation")
+
+	// direct computation
+	rng := rand.New(rand.NewSource(777))
+	a := randomArray(rng, 20)
+	b := randomArray(rng, 13)
+	M, N := len(a), len(b)
+	cref := make([]float64, M+N-1)
+	for k := 0; k < len(cref); k++ {
+		ℓ := k - (M - 1)
+		for j := 0; j < M; j++ {
+			if j+ℓ >= 0 && j+ℓ < N {
+				cref[k] += a[j] * b[j+ℓ]
+			}
+		}
+	}
+	c, err := CrossCorrelate(a, b)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "a⋆b", 1e-14, c, cref)
+
+	// autocorrelation peaks at zero lag
+	r, err := AutoCorrelate(a)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Int(tst, "len(r)", len(r), 2*M-1)
+	imax := 0
+	for k := 0; k < len(r); k++ {
+		if r[k] > r[imax] {
+			imax = k
+		}
+		chk.Float64(tst, io.Sf("r[%d] = r[%d]", k, len(r)-1-k), 1e-14, r[k], r[len(r)-1-k])
+	}
+	chk.Int(tst, "zero lag index", imax, M-1)
+
+	// correlation with shifted copy peaks at the shift
+	d := 7
+	x := randomArray(rng, 64)
+	y := make([]float64, len(x))
+	for j := d; j < len(x); j++ {
+		y[j] = x[j-d]
+	}
+	c, _ = CrossCorrelate(x, y)
+	imax = 0
+	for k := 0; k < len(c); k++ {
+		if c[k] > c[imax] {
+			imax = k
+		}
+	}
+	chk.Int(tst, "lag of peak", imax-(len(x)-1), d)
+
+	// error
+	if _, err = CrossCorrelate(nil, b); err == nil {
+		tst.Errorf("empty input should have caused an error\n")
+		return
+	}
+}
+
+func TestToeplitzMatVec01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ToeplitzMatVec01. Toeplitz matrix times vector")
+
+	rng := rand.New(rand.NewSource(1234))
+	for _, mn := range [][]int{{1, 1}, {3, 3}, {4, 7}, {9, 2}, {50, 50}, {100, 37}} {
+		m, n := mn[0], mn[1]
+		col := randomArray(rng, m)
+		row := randomArray(rng, n)
+		row[0] = col[0]
+		x := randomArray(rng, n)
+
+		// naive product
+		ref := make([]float64, m)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				if i >= j {
+					ref[i] += col[i-j] * x[j]
+				} else {
+					ref[i] += row[j-i] * x[j]
+				}
+			}
+		}
+		y, err := ToeplitzMatVec(col, row, x)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Array(tst, io.Sf("%3d x %3d: T x", m, n), 1e-13, y, ref)
+	}
+
+	// symmetric Toeplitz matrix (e.g. covariance): [[2,1,0],[1,2,1],[0,1,2]]
+	y, err := ToeplitzMatVec([]float64{2, 1, 0}, []float64{2, 1, 0}, []float64{1, 2, 3})
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "symmetric", 1e-14, y, []float64{4, 8, 8})
+
+	// errors
+	if _, err = ToeplitzMatVec([]float64{1, 2}, []float64{1, 3}, []float64{1}); err == nil {
+		tst.Errorf("wrong length of x should have caused an error\n")
+	}
+	if _, err = ToeplitzMatVec([]float64{1, 2}, []float64{5, 3}, []float64{1, 1}); err == nil {
+		tst.Errorf("firstCol[0] != firstRow[0] should have caused an error\n")
+	}
+	if _, err = ToeplitzMatVec(nil, []float64{1}, []float64{1}); err == nil {
+		tst.Errorf("empty firstCol should have caused an error\n")
+	}
+}
+
+func TestPolyMul01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("PolyMul01. polynomial multiplication")
+
+	// (1+x)² = 1 + 2x + x²
+	chk.Array(tst, "(1+x)²", 1e-17, PolyMul([]float64{1, 1}, []float64{1, 1}), []float64{1, 2, 1})
+
+	// powers of (1+x) and a large product by FFT with integer coefficients (rounded)
+	a := []float64{1, 1}
+	p := []float64{1}
+	for i := 0; i < 10; i++ {
+		p = PolyMul(p, a)
+	}
+	chk.Array(tst, "(1+x)¹⁰", 1e-17, p, []float64{1, 10, 45, 120, 210, 252, 210, 120, 45, 10, 1})
+	big := make([]float64, 2000)
+	for i := range big {
+		big[i] = float64(i%7 - 3)
+	}
+	c := PolyMul(big, []float64{1, -1})
+	chk.Int(tst, "len(c)", len(c), 2001)
+	ref := convolveDirect(big, []float64{1, -1})
+	chk.Array(tst, "big ⋅ (1-x)", 1e-17, c, ref)
+
+	// random coefficients versus direct convolution
+	rng := rand.New(rand.NewSource(7))
+	for _, mn := range [][]int{{1, 1}, {3, 5}, {40, 40}, {100, 300}, {513, 1000}} {
+		a := randomArray(rng, mn[0])
+		b := randomArray(rng, mn[1])
+		c := PolyMul(a, b)
+		chk.Int(tst, "len(c)", len(c), mn[0]+mn[1]-1)
+		chk.Array(tst, io.Sf("%3d x %4d", mn[0], mn[1]), 1e-12, c, convolveDirect(a, b))
+	}
+
+	// empty
+	if PolyMul(nil, []float64{1}) != nil {
+		tst.Errorf("PolyMul with empty input should return nil\n")
+	}
+}
+
+func TestOverlapAdd01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("OverlapAdd01. long signal and 200-tap filter")
+
+	// long signal and filter
+	rng := rand.New(rand.NewSource(2016))
+	x := randomArray(rng, 100000)
+	h := randomArray(rng, 200)
+
+	// the working arrays of OverlapAdd have length blockLen = 1024 whereas Convolve transforms
+	// arrays of length NextPowerOfTwo(100199) = 131072
+	y, err := OverlapAdd(x, h, 1024)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	ref, err := Convolve(x, h, "full")
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Int(tst, "len(y)", len(y), len(x)+len(h)-1)
+	chk.Array(tst, "OverlapAdd = Convolve", 1e-11, y, ref)
+
+	// small cases, including a single partial block
+	for _, c := range [][]int{{1, 1, 2}, {5, 3, 4}, {10, 3, 4}, {37, 8, 16}, {3, 12, 16}} {
+		x, h := randomArray(rng, c[0]), randomArray(rng, c[1])
+		y, err := OverlapAdd(x, h, c[2])
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Array(tst, io.Sf("M=%2d N=%2d blockLen=%2d", c[0], c[1], c[2]), 1e-14, y, convolveDirect(x, h))
+	}
+
+	// errors
+	if _, err = OverlapAdd(x, h, 1000); err == nil {
+		tst.Errorf("blockLen=1000 should have caused an error\n")
+	}
+	if _, err = OverlapAdd(x, h, 128); err == nil {
+		tst.Errorf("blockLen ≤ len(h) should have caused an error\n")
+	}
+	if _, err = OverlapAdd(nil, h, 1024); err == nil {
+		tst.Errorf("empty x should have caused an error\n")
+	}
+}
+
+func TestOverlapSave01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("OverlapSave01. overlap-save versus overlap-add and direct convolution")
+
+	rng := rand.New(rand.NewSource(2017))
+	for _, c := range [][]int{{1, 1, 2}, {5, 3, 4}, {10, 3, 4}, {37, 8, 16}, {3, 12, 16}, {1000, 33, 64}, {20000, 200, 512}} {
+		x, h := randomArray(rng, c[0]), randomArray(rng, c[1])
+		y, err := OverlapSave(x, h, c[2])
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		yoa, err := OverlapAdd(x, h, c[2])
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		msg := io.Sf("M=%5d N=%3d blockLen=%3d", c[0], c[1], c[2])
+		chk.Int(tst, msg+": len(y)", len(y), c[0]+c[1]-1)
+		chk.Array(tst, msg+": OverlapSave = OverlapAdd", 1e-12, y, yoa)
+		chk.Array(tst, msg+": OverlapSave = direct", 1e-12, y, convolveDirect(x, h))
+	}
+
+	// errors
+	x, h := randomArray(rng, 100), randomArray(rng, 10)
+	if _, err := OverlapSave(x, h, 24); err == nil {
+		tst.Errorf("blockLen=24 should have caused an error\n")
+	}
+	if _, err := OverlapSave(x, h, 8); err == nil {
+		tst.Errorf("blockLen ≤ len(h) should have caused an error\n")
+	}
+	if _, err := OverlapSave(x, nil, 16); err == nil {
+		tst.Errorf("empty h should have caused an error\n")
+	}
+}
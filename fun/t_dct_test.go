@@ -0,0 +1,139 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// dctslow computes the orthonormal DCT-II by directly computing the summation
+func dctslow(x []float64) (X []float64) {
+	N := len(x)
+	X = make([]float64, N)
+	for k := 0; k < N; k++ {
+		for j := 0; j < N; j++ {
+			X[k] += x[j] * math.Cos(math.Pi*float64(k*(2*j+1))/float64(2*N))
+		}
+		if k == 0 {
+			X[k] *= math.Sqrt(1.0 / float64(N))
+		} else {
+			X[k] *= math.Sqrt(2.0 / float64(N))
+		}
+	}
+	return
+}
+
+func TestDct01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Dct01. DCT-II of [1,2,3,4]")
+
+	// reference values: scipy.fftpack.dct([1,2,3,4], norm='ortho')
+	x := []float64{1, 2, 3, 4}
+	X := Dct(x)
+	chk.Array(tst, "X", 1e-14, X, []float64{5, -2.230442497387663, 0, -0.15851266778110815})
+
+	// inverse
+	chk.Array(tst, "Idct(X) = x", 1e-14, Idct(X), x)
+}
+
+func TestDct02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Dct02. DCT-II and DCT-III are inverses")
+
+	for _, N := range []int{1, 2, 8, 32, 256} {
+		x := make([]float64, N)
+		for j, v := range fftTestData(N) {
+			x[j] = real(v)
+		}
+		X := Dct(x)
+		chk.Array(tst, io.Sf("N=%3d: Dct(x) = slow DCT", N), 1e-12, X, dctslow(x))
+		chk.Array(tst, io.Sf("N=%3d: Idct(Dct(x)) = x", N), 1e-12, Idct(X), x)
+	}
+}
+
+func TestDst01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Dst01. DST-I and its inverse")
+
+	for _, N := range []int{2, 4, 16, 128} {
+
+		// data at interior points
+		x := make([]float64, N-1)
+		for j, v := range fftTestData(N - 1) {
+			x[j] = real(v)
+		}
+
+		// slow DST-I
+		Xref := make([]float64, N-1)
+		for k := 0; k < N-1; k++ {
+			for j := 0; j < N-1; j++ {
+				Xref[k] += x[j] * math.Sin(math.Pi*float64((j+1)*(k+1))/float64(N))
+			}
+		}
+
+		// check
+		X := Dst(x)
+		chk.Array(tst, io.Sf("N=%3d: Dst(x) = slow DST", N), 1e-12, X, Xref)
+		chk.Array(tst, io.Sf("N=%3d: Idst(Dst(x)) = x", N), 1e-13, Idst(X), x)
+	}
+}
+
+func TestDst02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Dst02. Poisson equation with Dirichlet boundary conditions")
+
+	// problem: d²u/dx² = f(x) with u(0) = u(L) = 0 and
+	//   f(x) = -(π/L)² sin(π x / L) - 9 (π/L)² sin(3 π x / L) / 2
+	// solution:
+	//   u(x) = sin(π x / L) + sin(3 π x / L) / 2
+	L := 2.0
+	f := func(x float64) float64 {
+		return -math.Pow(math.Pi/L, 2)*math.Sin(math.Pi*x/L) - 4.5*math.Pow(math.Pi/L, 2)*math.Sin(3*math.Pi*x/L)
+	}
+	u := func(x float64) float64 {
+		return math.Sin(math.Pi*x/L) + 0.5*math.Sin(3*math.Pi*x/L)
+	}
+
+	// grid with N intervals
+	N := 32
+	h := L / float64(N)
+	xx := make([]float64, N-1) // interior points
+	ff := make([]float64, N-1)
+	for j := 0; j < N-1; j++ {
+		xx[j] = float64(j+1) * h
+		ff[j] = f(xx[j])
+	}
+
+	// solve in the sine space: û[k] = -f̂[k] / (k π / L)²
+	F := Dst(ff)
+	for k := 0; k < N-1; k++ {
+		κ := float64(k+1) * math.Pi / L
+		F[k] /= -κ * κ
+	}
+	uu := Idst(F)
+
+	// check
+	uref := make([]float64, N-1)
+	for j := 0; j < N-1; j++ {
+		uref[j] = u(xx[j])
+	}
+	chk.Array(tst, "u", 1e-13, uu, uref)
+
+	// second-order finite differences: û[k] = f̂[k] / λ[k] with λ[k] = -(4/h²) sin²(k π / 2N)
+	F = Dst(ff)
+	for k := 0; k < N-1; k++ {
+		λ := -4.0 / (h * h) * math.Pow(math.Sin(float64(k+1)*math.Pi/float64(2*N)), 2)
+		F[k] /= λ
+	}
+	chk.Array(tst, "u (finite differences)", 5e-3, Idst(F), uref)
+}
@@ -206,3 +206,53 @@ func TestDft03(tst *testing.T) {
 		}
 	}
 }
+
+func TestDftMatrix01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("DftMatrix01. explicit DFT matrix")
+
+	// matrix-vector product versus Dft
+	for _, n := range []int{4, 8} {
+		x := fftTestData(n)
+		for _, inverse := range []bool{false, true} {
+			W := DftMatrix(n, inverse)
+			X := make([]complex128, n)
+			for k := 0; k < n; k++ {
+				for l := 0; l < n; l++ {
+					X[k] += W[k][l] * x[l]
+				}
+			}
+			Xref, err := Dft(x, inverse)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			chk.ArrayC(tst, io.Sf("n=%d inverse=%v: W⋅x = Dft(x)", n, inverse), 1e-14, X, Xref)
+		}
+	}
+
+	// known matrix with n = 4
+	io.Pl()
+	W := DftMatrix(4, false)
+	chk.ArrayC(tst, "W[1]", 1e-15, W[1], []complex128{1, -1i, -1, 1i})
+	chk.ArrayC(tst, "W[2]", 1e-15, W[2], []complex128{1, -1, 1, -1})
+	chk.ArrayC(tst, "W[3]", 1e-15, W[3], []complex128{1, 1i, -1, -1i})
+
+	// forward ⋅ inverse = identity (also for n that is not a power of 2)
+	for _, n := range []int{1, 3, 8, 12} {
+		F := DftMatrix(n, false)
+		G := DftMatrix(n, true)
+		for i := 0; i < n; i++ {
+			row := make([]complex128, n)
+			for j := 0; j < n; j++ {
+				for k := 0; k < n; k++ {
+					row[j] += F[i][k] * G[k][j]
+				}
+			}
+			I := make([]complex128, n)
+			I[i] = 1
+			chk.ArrayC(tst, io.Sf("n=%2d: (F⋅G)[%d]", n, i), 1e-14, row, I)
+		}
+	}
+}
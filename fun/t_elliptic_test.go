@@ -143,3 +143,58 @@ func Test_elliptic03(tst *testing.T) {
 		}
 	}
 }
+
+func TestEllipticKE01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("EllipticKE01. complete elliptic integrals by AGM")
+
+	// tabulated values
+	for _, c := range [][]float64{
+		{0, math.Pi / 2, math.Pi / 2},
+		{0.1, 1.612441348720219, 1.530757636897763},
+		{0.5, 1.854074677301372, 1.350643881047675},
+		{0.9, 2.578092113348173, 1.104774732704073},
+		{0.99, 3.695637362989875, 1.015993545025224},
+	} {
+		m := c[0]
+		chk.Float64(tst, io.Sf("K(%g)", m), 1e-14, EllipticK(m), c[1])
+		chk.Float64(tst, io.Sf("E(%g)", m), 1e-14, EllipticE(m), c[2])
+	}
+
+	// compare with the incomplete integrals at φ = π/2
+	io.Pl()
+	for _, m := range []float64{0.05, 0.2, 0.35, 0.6, 0.75, 0.95, 0.999} {
+		k := math.Sqrt(m)
+		chk.Float64(tst, io.Sf("K(%g) = F(π/2,k)", m), 1e-13, EllipticK(m), Elliptic1(math.Pi/2, k))
+		chk.Float64(tst, io.Sf("E(%g) = E(π/2,k)", m), 1e-13, EllipticE(m), Elliptic2(math.Pi/2, k))
+	}
+
+	// Legendre's relation: E(m) K(1-m) + E(1-m) K(m) - K(m) K(1-m) = π/2
+	io.Pl()
+	for _, m := range []float64{0.01, 0.3, 0.5, 0.8} {
+		K, Kp, E, Ep := EllipticK(m), EllipticK(1-m), EllipticE(m), EllipticE(1-m)
+		chk.Float64(tst, io.Sf("Legendre(%g)", m), 1e-14, E*Kp+Ep*K-K*Kp, math.Pi/2)
+	}
+
+	// bounded number of iterations
+	for _, m := range []float64{0, 0.5, 0.9, 0.999999, 1 - 1e-15} {
+		_, _, nit := ellipticAgm(m)
+		io.Pforan("m = %v  nit = %d\n", m, nit)
+		if nit > 10 {
+			tst.Errorf("AGM took too many iterations: %d\n", nit)
+			return
+		}
+	}
+
+	// special cases
+	if !math.IsInf(EllipticK(1), +1) {
+		tst.Errorf("K(1) should be +Inf\n")
+		return
+	}
+	chk.Float64(tst, "E(1)", 1e-17, EllipticE(1), 1)
+	if !math.IsNaN(EllipticK(-0.1)) || !math.IsNaN(EllipticE(1.1)) {
+		tst.Errorf("m outside [0,1] should give NaN\n")
+		return
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestErf01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Erf01. error function and complementary error function")
+
+	// reference values
+	for _, c := range [][]float64{
+		{0, 0},
+		{0.1, 0.1124629160182849},
+		{0.5, 0.5204998778130465},
+		{1, 0.8427007929497149},
+		{2, 0.9953222650189527},
+		{3.5, 0.9999992569016276},
+	} {
+		chk.Float64(tst, io.Sf("erf(%g)", c[0]), 1e-15, Erf(c[0]), c[1])
+		chk.Float64(tst, io.Sf("erf(%g)", -c[0]), 1e-15, Erf(-c[0]), -c[1])
+	}
+	io.Pl()
+	for _, c := range [][]float64{
+		{0.5, 0.4795001221869535},
+		{1.5, 0.033894853524689274},
+		{3, 2.2090496998585438e-05},
+		{5, 1.5374597944280351e-12},
+		{10, 2.088487583762545e-45},
+		{26, 5.663192408856143e-296},
+	} {
+		chk.AnaNum(tst, io.Sf("erfc(%g)", c[0]), 1e-14*c[1], Erfc(c[0]), c[1], chk.Verbose)
+	}
+
+	// limits and special values
+	chk.Float64(tst, "erf(+Inf)", 1e-17, Erf(math.Inf(+1)), 1)
+	chk.Float64(tst, "erf(-Inf)", 1e-17, Erf(math.Inf(-1)), -1)
+	chk.Float64(tst, "erfc(+Inf)", 1e-17, Erfc(math.Inf(+1)), 0)
+	chk.Float64(tst, "erfc(-Inf)", 1e-17, Erfc(math.Inf(-1)), 2)
+	chk.Float64(tst, "erfc(30) underflows", 1e-17, Erfc(30), 0)
+	chk.Float64(tst, "erfc(-3)", 1e-15, Erfc(-3), 2-2.2090496998585438e-05)
+	if !math.IsNaN(Erf(math.NaN())) || !math.IsNaN(Erfc(math.NaN())) {
+		tst.Errorf("erf(NaN) and erfc(NaN) should be NaN\n")
+		return
+	}
+
+	// compare with math.Erf and math.Erfc
+	io.Pl()
+	for x := -6.0; x <= 6.0; x += 0.37 {
+		chk.AnaNum(tst, io.Sf("erf(%g)", x), 1e-15, Erf(x), math.Erf(x), false)
+		ref := math.Erfc(x)
+		chk.AnaNum(tst, io.Sf("erfc(%g)", x), 1e-14*ref, Erfc(x), ref, false)
+	}
+}
+
+func TestErfInv01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ErfInv01. inverse error function")
+
+	// round trip (for larger |x|, Erf(x) is too close to ±1 to be inverted accurately)
+	for _, x := range []float64{-2.5, -2, -1, -0.5, -1e-5, 1e-10, 0.01, 0.3, 0.9, 1.7, 2.2} {
+		chk.AnaNum(tst, io.Sf("ErfInv(Erf(%g))", x), 1e-13*math.Max(1, math.Abs(x)), ErfInv(Erf(x)), x, chk.Verbose)
+	}
+
+	// compare with math.Erfinv
+	io.Pl()
+	for _, p := range []float64{-0.999999, -0.9, -0.5, -0.1, 0.2, 0.6, 0.95, 0.9999, 1 - 1e-12} {
+		chk.AnaNum(tst, io.Sf("ErfInv(%g)", p), 1e-13, ErfInv(p), math.Erfinv(p), chk.Verbose)
+	}
+
+	// special cases
+	chk.Float64(tst, "ErfInv(0)", 1e-17, ErfInv(0), 0)
+	if !math.IsInf(ErfInv(1), +1) || !math.IsInf(ErfInv(-1), -1) {
+		tst.Errorf("ErfInv(±1) should be ±Inf\n")
+		return
+	}
+	if !math.IsNaN(ErfInv(1.1)) || !math.IsNaN(ErfInv(-2)) {
+		tst.Errorf("ErfInv(|p|>1) should be NaN\n")
+		return
+	}
+}
+
+func TestDawson01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Dawson01. Dawson's integral")
+
+	// reference values (computed with 80 digits)
+	X := []float64{0.1, 0.5, 1, 2, 3, 5, 7, 10, 20}
+	R := []float64{
+		9.93359923978528597255e-02,
+		4.24436383502022285263e-01,
+		5.38079506912768401605e-01,
+		3.01340388923791946407e-01,
+		1.78271030610558295360e-01,
+		1.02134074424276841131e-01,
+		7.21809746582362937728e-02,
+		5.02538471875985312542e-02,
+		2.50313679264036713368e-02,
+	}
+	for i, x := range X {
+		chk.AnaNum(tst, io.Sf("D(%g)", x), 1e-15*R[i], Dawson(x), R[i], chk.Verbose)
+	}
+
+	// odd symmetry
+	io.Pl()
+	for _, x := range []float64{1e-8, 0.3, 0.924, 2.5, 6.4, 6.6, 50} {
+		chk.Float64(tst, io.Sf("D(-%g) = -D(%g)", x, x), 1e-17, Dawson(-x), -Dawson(x))
+	}
+
+	// maximum: D'(x) = 1 - 2 x D(x) = 0
+	xmax, dmax := 0.92413887300459176701, 0.54104422463518169847
+	chk.Float64(tst, "D(xmax)", 1e-15, Dawson(xmax), dmax)
+	chk.Float64(tst, "D'(xmax)", 1e-15, 1-2*xmax*Dawson(xmax), 0)
+	if Dawson(xmax-1e-3) >= dmax || Dawson(xmax+1e-3) >= dmax {
+		tst.Errorf("D should have its maximum at x = %v\n", xmax)
+		return
+	}
+
+	// continuity at the switch between series and asymptotic expansion
+	chk.Float64(tst, "D across switch", 1e-15, Dawson(dawsonSwitch), dawsonSeries(dawsonSwitch))
+
+	// large arguments and special cases
+	chk.Float64(tst, "D(1e10)", 1e-30, Dawson(1e10), 0.5e-10)
+	chk.Float64(tst, "D(1e300)", 1e-310, Dawson(1e300), 0.5e-300)
+	chk.Float64(tst, "D(0)", 1e-17, Dawson(0), 0)
+	chk.Float64(tst, "D(+Inf)", 1e-17, Dawson(math.Inf(+1)), 0)
+	if !math.IsNaN(Dawson(math.NaN())) {
+		tst.Errorf("D(NaN) should be NaN\n")
+		return
+	}
+}
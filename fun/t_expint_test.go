@@ -0,0 +1,107 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestExpInt01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ExpInt01. exponential integrals Ei and E1")
+
+	// reference values computed with 80-digit arithmetic
+	for _, c := range [][]float64{
+		{0.5, 4.5421990486317360e-01},
+		{1, 1.8951178163559368e+00},
+		{1.5, 3.3012854491297978e+00},
+		{2, 4.9542343560018898e+00},
+		{10, 2.4922289762418777e+03},
+		{39, 2.2804462003019025e+15},
+		{41, 1.6006649143245042e+16},
+		{50, 1.0585636897131690e+20},
+		{100, 2.7155527448538798e+41},
+		{700, 1.4509787360525608e+301},
+	} {
+		x, ref := c[0], c[1]
+		chk.Float64(tst, io.Sf("Ei(%g)/ref", x), 1e-14, Ei(x)/ref, 1)
+	}
+
+	// E1(x) = -Ei(-x)
+	io.Pl()
+	for _, c := range [][]float64{
+		{0.1, 1.8229239584193906e+00},
+		{0.5, 5.5977359477616084e-01},
+		{1, 2.1938393439552029e-01},
+		{1.5, 1.0001958240663265e-01},
+		{2, 4.8900510708061118e-02},
+		{10, 4.1569689296853246e-06},
+		{30, 3.0215520106888124e-15},
+	} {
+		x, ref := c[0], c[1]
+		chk.Float64(tst, io.Sf("E1(%g)/ref", x), 1e-14, E1(x)/ref, 1)
+		chk.Float64(tst, io.Sf("Ei(%g)/ref", -x), 1e-14, -Ei(-x)/ref, 1)
+	}
+
+	// special cases
+	if !math.IsInf(Ei(0), -1) || !math.IsInf(E1(0), +1) || !math.IsInf(Ei(800), +1) {
+		tst.Errorf("Ei(0), E1(0) or Ei(800) are incorrect\n")
+		return
+	}
+	chk.Float64(tst, "E1(800)", 1e-17, E1(800), 0)
+	if !math.IsNaN(E1(-1)) || !math.IsNaN(Ei(math.NaN())) {
+		tst.Errorf("E1(-1) and Ei(NaN) should be NaN\n")
+		return
+	}
+}
+
+func TestSinCosInt01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("SinCosInt01. sine and cosine integrals")
+
+	// reference values computed with 80-digit arithmetic
+	for _, c := range [][]float64{
+		{0.5, 4.9310741804306668e-01, -1.7778407880661290e-01},
+		{1, 9.4608307036718298e-01, 3.3740392290096816e-01},
+		{1.9, 1.5577753137488186e+00, 4.4194034968159884e-01},
+		{2, 1.6054129768026948e+00, 4.2298082877486498e-01},
+		{2.1, 1.6486986362444187e+00, 4.0051198784439640e-01},
+		{5, 1.5499312449446740e+00, -1.9002974965664388e-01},
+		{10, 1.6583475942188741e+00, -4.5456433004455371e-02},
+		{30, 1.5667565400303511e+00, -3.3032417282071146e-02},
+		{100, 1.5622254668890563e+00, -5.1488251426104921e-03},
+	} {
+		x := c[0]
+		chk.Float64(tst, io.Sf("Si(%g)", x), 1e-15, Si(x), c[1])
+		chk.Float64(tst, io.Sf("Ci(%g)", x), 1e-15, Ci(x), c[2])
+		chk.Float64(tst, io.Sf("Si(%g)", -x), 1e-15, Si(-x), -c[1])
+	}
+
+	// limits
+	io.Pl()
+	chk.Float64(tst, "Si(0)", 1e-17, Si(0), 0)
+	chk.Float64(tst, "Si(+Inf)", 1e-17, Si(math.Inf(+1)), math.Pi/2)
+	chk.Float64(tst, "Si(1e8)", 1e-8, Si(1e8), math.Pi/2)
+	chk.Float64(tst, "Ci(+Inf)", 1e-17, Ci(math.Inf(+1)), 0)
+	chk.Float64(tst, "Ci(1e8)", 1e-8, Ci(1e8), 0)
+	if !math.IsInf(Ci(0), -1) || !math.IsNaN(Ci(-1)) {
+		tst.Errorf("Ci(0) should be -Inf and Ci(-1) should be NaN\n")
+		return
+	}
+
+	// first zero of Ci
+	x0 := 0.61650548562071628
+	chk.Float64(tst, "Ci(x0)", 1e-15, Ci(x0), 0)
+	if Ci(0.61) >= 0 || Ci(0.62) <= 0 {
+		tst.Errorf("Ci should cross zero between 0.61 and 0.62\n")
+		return
+	}
+}
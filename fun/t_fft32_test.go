@@ -0,0 +1,67 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestFourierTransLL3201(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierTransLL3201. single precision FFT")
+
+	for _, N := range []int{1, 2, 8, 64, 1024} {
+
+		// data
+		x := fftTestData(N)
+		x32 := make([]complex64, N)
+		for i := 0; i < N; i++ {
+			x32[i] = complex64(x[i])
+		}
+
+		// forward versus float64 version
+		X, err := Dft(x, false)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		X32, err := Dft32(x32, false)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		Xr := make([]complex128, N)
+		for i := 0; i < N; i++ {
+			Xr[i] = complex128(X32[i])
+		}
+		chk.ArrayC(tst, io.Sf("N=%4d: Dft32(x) = Dft(x)", N), 2e-7*float64(N), Xr, X)
+
+		// round trip
+		y32, err := Dft32(X32, true)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		y := make([]complex128, N)
+		orig := make([]complex128, N)
+		for i := 0; i < N; i++ {
+			y[i] = complex128(y32[i])
+			orig[i] = complex128(x32[i])
+		}
+		chk.ArrayC(tst, io.Sf("N=%4d: Dft32(Dft32(x), inverse) = x", N), 1e-5, y, orig)
+	}
+
+	// errors
+	if err := FourierTransLL32(make([]float32, 5), false); err == nil {
+		tst.Errorf("odd length of data should have caused an error\n")
+	}
+	if _, err := Dft32(make([]complex64, 3), false); err == nil {
+		tst.Errorf("N=3 should have caused an error\n")
+	}
+}
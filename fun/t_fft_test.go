@@ -0,0 +1,312 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// fftTestData returns a deterministic (pseudo-random looking) complex array with N values
+func fftTestData(N int) (x []complex128) {
+	x = make([]complex128, N)
+	for i := 0; i < N; i++ {
+		t := float64(i)
+		x[i] = complex(math.Sin(1.3*t)+0.5*math.Cos(0.7*t*t), math.Cos(2.1*t)-0.25*t/float64(N))
+	}
+	return
+}
+
+// fftPack converts complex values to real/imag pairs
+func fftPack(x []complex128) (data []float64) {
+	data = make([]float64, 2*len(x))
+	for i, v := range x {
+		data[2*i] = real(v)
+		data[2*i+1] = imag(v)
+	}
+	return
+}
+
+func TestFourierTransLL01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierTransLL01. forward FFT versus slow DFT")
+
+	for _, N := range []int{1, 2, 4, 8, 16, 32, 64} {
+		x := fftTestData(N)
+		data := fftPack(x)
+		err := FourierTransLL(data, false)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		X := make([]complex128, N)
+		for i := 0; i < N; i++ {
+			X[i] = complex(data[2*i], data[2*i+1])
+		}
+		chk.ArrayC(tst, io.Sf("N=%2d: X = DFT[x]", N), 1e-12, X, dft1dslow(x))
+	}
+}
+
+func TestFourierTransLL02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierTransLL02. forward and inverse FFT (round trip)")
+
+	for _, N := range []int{1, 2, 4, 8, 16, 128, 1024, 4096} {
+		data := fftPack(fftTestData(N))
+		orig := make([]float64, len(data))
+		copy(orig, data)
+		err := FourierTransLL(data, false)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		err = FourierTransLL(data, true)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Array(tst, io.Sf("N=%4d: invFFT(FFT(x)) = x", N), 1e-12, data, orig)
+	}
+}
+
+func TestFourierTransLL03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierTransLL03. invalid input")
+
+	if err := FourierTransLL(make([]float64, 5), false); err == nil {
+		tst.Errorf("odd length of data should have caused an error\n")
+		return
+	}
+	if err := FourierTransLL(make([]float64, 6), false); err == nil {
+		tst.Errorf("N=3 is not a power of two and should have caused an error\n")
+		return
+	}
+	if err := FourierTransLL(nil, false); err == nil {
+		tst.Errorf("empty data should have caused an error\n")
+		return
+	}
+}
+
+func TestFourierTransChecked01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierTransChecked01. NaN and Inf in input")
+
+	// finite values
+	data := fftPack(fftTestData(8))
+	ref := make([]float64, len(data))
+	copy(ref, data)
+	err := FourierTransChecked(data, false)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	err = FourierTransLL(ref, false)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "FourierTransChecked = FourierTransLL", 1e-17, data, ref)
+
+	// NaN
+	data = fftPack(fftTestData(8))
+	data[5] = math.NaN()
+	orig := make([]float64, len(data))
+	copy(orig, data)
+	err = FourierTransChecked(data, false)
+	if err == nil {
+		tst.Errorf("NaN should have caused an error\n")
+		return
+	}
+	io.Pforan("%v\n", err)
+	chk.String(tst, err.Error(), "data[5] = NaN is not finite (imaginary part of complex value 2)")
+	for i := 0; i < len(data); i++ {
+		if i != 5 && data[i] != orig[i] {
+			tst.Errorf("data must not be modified when the check fails\n")
+			return
+		}
+	}
+
+	// Inf
+	data = fftPack(fftTestData(8))
+	data[10] = math.Inf(-1)
+	err = FourierTransChecked(data, true)
+	if err == nil {
+		tst.Errorf("-Inf should have caused an error\n")
+		return
+	}
+	chk.String(tst, err.Error(), "data[10] = -Inf is not finite (real part of complex value 5)")
+}
+
+func TestDft04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Dft04. Dft and DftInplace with 4 points")
+
+	// hand-computed results
+	x := []complex128{1 + 2i, 3 + 4i, 5 + 6i, 7 + 8i}
+	Xref := []complex128{16 + 20i, -8, -4 - 4i, -8i}
+
+	// allocating version
+	X, err := Dft(x, false)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.ArrayC(tst, "X = Dft(x)", 1e-14, X, Xref)
+	chk.ArrayC(tst, "x is unchanged", 1e-17, x, []complex128{1 + 2i, 3 + 4i, 5 + 6i, 7 + 8i})
+	y, err := Dft(X, true)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.ArrayC(tst, "Dft(X, inverse) = x", 1e-14, y, x)
+
+	// in-place version
+	u := []complex128{1, 2, 3, 4}
+	err = DftInplace(u, false)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.ArrayC(tst, "DftInplace([1,2,3,4])", 1e-14, u, []complex128{10, -2 + 2i, -2, -2 - 2i})
+	err = DftInplace(u, true)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.ArrayC(tst, "DftInplace(U, inverse)", 1e-14, u, []complex128{1, 2, 3, 4})
+
+	// errors
+	_, err = Dft(make([]complex128, 3), false)
+	errLL := FourierTransLL(make([]float64, 6), false)
+	if err == nil || errLL == nil {
+		tst.Errorf("N=3 should have caused an error\n")
+		return
+	}
+	chk.String(tst, err.Error(), errLL.Error())
+	err = DftInplace(make([]complex128, 6), false)
+	if err == nil {
+		tst.Errorf("N=6 should have caused an error\n")
+		return
+	}
+}
+
+func TestDftSplit01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("DftSplit01. separate real and imaginary arrays versus FourierTransLL")
+
+	for _, N := range []int{1, 2, 4, 16, 256} {
+		for _, inverse := range []bool{false, true} {
+			x := fftTestData(N)
+			data := fftPack(x)
+			re := make([]float64, N)
+			im := make([]float64, N)
+			for i, v := range x {
+				re[i], im[i] = real(v), imag(v)
+			}
+			err := DftSplit(re, im, inverse)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			err = FourierTransLL(data, inverse)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			packed := make([]float64, 2*N)
+			for i := 0; i < N; i++ {
+				packed[2*i], packed[2*i+1] = re[i], im[i]
+			}
+			chk.Array(tst, io.Sf("N=%3d inverse=%5v: DftSplit = LL", N, inverse), 1e-17, packed, data)
+		}
+	}
+
+	// no allocations
+	re, im := make([]float64, 256), make([]float64, 256)
+	allocs := testing.AllocsPerRun(10, func() {
+		DftSplit(re, im, false)
+		DftSplit(re, im, true)
+	})
+	if allocs != 0 {
+		tst.Errorf("DftSplit must not allocate memory. allocations per run = %v\n", allocs)
+		return
+	}
+
+	// invalid input
+	if err := DftSplit(make([]float64, 4), make([]float64, 2), false); err == nil {
+		tst.Errorf("different lengths should have caused an error\n")
+		return
+	}
+	if err := DftSplit(make([]float64, 6), make([]float64, 6), false); err == nil {
+		tst.Errorf("N=6 is not a power of two and should have caused an error\n")
+		return
+	}
+}
+
+func TestRealFourierTrans01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("RealFourierTrans01. half spectrum of real data")
+
+	for _, N := range []int{1, 2, 4, 8, 16, 64, 256} {
+
+		// real data
+		x := make([]float64, N)
+		xc := fftTestData(N)
+		for i := 0; i < N; i++ {
+			x[i] = real(xc[i])
+			xc[i] = complex(x[i], 0)
+		}
+
+		// half spectrum versus full spectrum
+		X, err := RealFourierTrans(x)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		Xfull, err := Dft(xc, false)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.ArrayC(tst, io.Sf("N=%3d: RealFourierTrans(x) = Dft(x)[:N/2+1]", N), 1e-12, X, Xfull[:N/2+1])
+
+		// inverse
+		y, err := RealFourierTransInv(X, N)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Array(tst, io.Sf("N=%3d: RealFourierTransInv(X) = x", N), 1e-13, y, x)
+	}
+}
+
+func TestRealFourierTrans02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("RealFourierTrans02. invalid input")
+
+	if _, err := RealFourierTrans(make([]float64, 6)); err == nil {
+		tst.Errorf("N=6 should have caused an error\n")
+		return
+	}
+	if _, err := RealFourierTransInv(make([]complex128, 4), 6); err == nil {
+		tst.Errorf("n=6 should have caused an error\n")
+		return
+	}
+	if _, err := RealFourierTransInv(make([]complex128, 4), 8); err == nil {
+		tst.Errorf("len(spectrum)=4 with n=8 should have caused an error\n")
+		return
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+func TestFftShift01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FftShift01. fftshift and ifftshift")
+
+	// even length: numpy.fft.fftshift(range(8))
+	X := []complex128{0, 1, 2, 3, 4, 5, 6, 7}
+	chk.ArrayC(tst, "fftshift (even) ", 1e-17, FftShift(X), []complex128{4, 5, 6, 7, 0, 1, 2, 3})
+	chk.ArrayC(tst, "ifftshift (even)", 1e-17, IfftShift(X), []complex128{4, 5, 6, 7, 0, 1, 2, 3})
+
+	// odd length: numpy.fft.fftshift(range(5)) and numpy.fft.ifftshift(range(5))
+	X = []complex128{0, 1, 2, 3, 4}
+	chk.ArrayC(tst, "fftshift (odd)  ", 1e-17, FftShift(X), []complex128{3, 4, 0, 1, 2})
+	chk.ArrayC(tst, "ifftshift (odd) ", 1e-17, IfftShift(X), []complex128{2, 3, 4, 0, 1})
+
+	// round trip
+	for _, n := range []int{0, 1, 2, 3, 6, 7, 16} {
+		x := fftTestData(n)
+		chk.ArrayC(tst, io.Sf("n=%2d: ifftshift(fftshift(x)) = x", n), 1e-17, IfftShift(FftShift(x)), x)
+		chk.ArrayC(tst, io.Sf("n=%2d: fftshift(ifftshift(x)) = x", n), 1e-17, FftShift(IfftShift(x)), x)
+	}
+}
+
+func TestBitReverse01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("BitReverse01. bit-reversal permutation")
+
+	// known values
+	chk.Ints(tst, "n=1", BitReverseIndices(1), []int{0})
+	chk.Ints(tst, "n=2", BitReverseIndices(2), []int{0, 1})
+	chk.Ints(tst, "n=8", BitReverseIndices(8), []int{0, 4, 2, 6, 1, 5, 3, 7})
+	chk.Ints(tst, "n=16", BitReverseIndices(16), []int{0, 8, 4, 12, 2, 10, 6, 14, 1, 9, 5, 13, 3, 11, 7, 15})
+
+	for _, n := range []int{1, 2, 4, 32, 1024} {
+
+		// the permutation is its own inverse
+		p := BitReverseIndices(n)
+		pp := make([]int, n)
+		for i := 0; i < n; i++ {
+			pp[i] = p[p[i]]
+		}
+		chk.Ints(tst, io.Sf("n=%4d: p[p[i]] = i", n), pp, utl.IntRange(n))
+
+		// same swaps as in the bit reversal section of FourierTransLL (also used by FourierPlan)
+		plan, err := NewFourierPlan(n)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		var swaps []int
+		for i, j := range p {
+			if j > i {
+				swaps = append(swaps, i, j)
+			}
+		}
+		chk.Ints(tst, io.Sf("n=%4d: swaps = FourierTransLL swaps", n), swaps, plan.swaps)
+
+		// permute twice recovers the data
+		x := fftTestData(n)
+		y := make([]complex128, n)
+		copy(y, x)
+		BitReversePermute(y)
+		for i := 0; i < n; i++ {
+			if y[i] != x[p[i]] {
+				tst.Errorf("n=%d: y[%d] = %v should be equal to x[%d] = %v\n", n, i, y[i], p[i], x[p[i]])
+				return
+			}
+		}
+		BitReversePermute(y)
+		chk.ArrayC(tst, io.Sf("n=%4d: permute(permute(x)) = x", n), 1e-17, y, x)
+	}
+
+	// invalid size
+	defer func() {
+		if err := recover(); err != nil {
+			io.Pf("OK: n=6 caused panic: %v\n", err)
+		} else {
+			tst.Errorf("n=6 should have caused a panic\n")
+		}
+	}()
+	BitReverseIndices(6)
+}
+
+func TestFftFreq01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FftFreq01. frequencies of bins")
+
+	// numpy.fft.fftfreq(8, 0.1)
+	chk.Array(tst, "fftfreq(8,0.1) ", 1e-15, FftFreq(8, 0.1), []float64{0, 1.25, 2.5, 3.75, -5, -3.75, -2.5, -1.25})
+
+	// numpy.fft.fftfreq(9, 1) ⋅ 9
+	f := FftFreq(9, 1)
+	for k := 0; k < len(f); k++ {
+		f[k] *= 9
+	}
+	chk.Array(tst, "fftfreq(9,1)⋅9 ", 1e-14, f, []float64{0, 1, 2, 3, 4, -4, -3, -2, -1})
+
+	// numpy.fft.rfftfreq(8, 0.1) and numpy.fft.rfftfreq(9, 1) ⋅ 9
+	chk.Array(tst, "rfftfreq(8,0.1)", 1e-15, RfftFreq(8, 0.1), []float64{0, 1.25, 2.5, 3.75, 5})
+	f = RfftFreq(9, 1)
+	for k := 0; k < len(f); k++ {
+		f[k] *= 9
+	}
+	chk.Array(tst, "rfftfreq(9,1)⋅9", 1e-14, f, []float64{0, 1, 2, 3, 4})
+
+	// consistency with RealFourierTrans: a 12.5 Hz tone sampled at 100 Hz
+	n, fs := 64, 100.0
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		x[i] = math.Sin(2 * math.Pi * 12.5 * float64(i) / fs)
+	}
+	X, _ := RealFourierTrans(x)
+	chk.Int(tst, "len(rfftfreq) = len(X)", len(RfftFreq(n, 1/fs)), len(X))
+	io.Pforan("dominant frequency = %v\n", RfftFreq(n, 1/fs)[spectralArgmax(X)])
+	chk.Float64(tst, "dominant frequency", 1e-14, RfftFreq(n, 1/fs)[spectralArgmax(X)], 12.5)
+}
+
+func TestZeroPad01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ZeroPad01. zero-padding of real and complex arrays")
+
+	x := []float64{1, 2, 3}
+	y, err := ZeroPad(x, 8)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Int(tst, "len(y)", len(y), 8)
+	chk.Array(tst, "y", 1e-17, y, []float64{1, 2, 3, 0, 0, 0, 0, 0})
+	y[0] = 100
+	chk.Array(tst, "x is unchanged", 1e-17, x, []float64{1, 2, 3})
+
+	y, err = ZeroPad([]float64{1, 2, 3, 4}, 4)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "n = len(x)", 1e-17, y, []float64{1, 2, 3, 4})
+
+	z, err := ZeroPadComplex([]complex128{1 + 1i, 2 - 1i}, 4)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Int(tst, "len(z)", len(z), 4)
+	chk.ArrayC(tst, "z", 1e-17, z, []complex128{1 + 1i, 2 - 1i, 0, 0})
+
+	// errors
+	if _, err = ZeroPad(x, 2); err == nil {
+		tst.Errorf("n < len(x) should have caused an error\n")
+	}
+	if _, err = ZeroPad(x, 6); err == nil {
+		tst.Errorf("n=6 should have caused an error\n")
+	}
+	if _, err = ZeroPadComplex(make([]complex128, 5), 4); err == nil {
+		tst.Errorf("n < len(x) should have caused an error\n")
+	}
+}
+
+func TestMagnitude01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Magnitude01. magnitude, phase and decibels")
+
+	X := []complex128{4, -2, 3i, -1 - 1i, 0, 3 + 4i}
+	chk.Array(tst, "Magnitude", 1e-15, Magnitude(X), []float64{4, 2, 3, math.Sqrt2, 0, 5})
+	chk.Array(tst, "Phase", 1e-15, Phase(X), []float64{0, math.Pi, math.Pi / 2, -3 * math.Pi / 4, 0, math.Atan2(4, 3)})
+
+	dB := MagnitudeDB(X, 4)
+	chk.Array(tst, "MagnitudeDB", 1e-14, []float64{dB[0], dB[1], dB[2], dB[3], dB[5]}, []float64{
+		0,
+		20 * math.Log10(0.5),
+		20 * math.Log10(0.75),
+		20 * math.Log10(math.Sqrt2/4),
+		20 * math.Log10(1.25),
+	})
+	if !math.IsInf(dB[4], -1) {
+		tst.Errorf("MagnitudeDB of zero bin should be -Inf. %v is incorrect\n", dB[4])
+		return
+	}
+
+	// Hann window spectrum: the first side bins are -6.02 dB below the peak
+	S, err := Dft([]complex128{0, 0.5, 1, 0.5}, false)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	dB = MagnitudeDB(S, 2)
+	chk.Float64(tst, "dB[1]", 1e-14, dB[1], 20*math.Log10(0.5))
+}
+
+func TestUnwrap01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Unwrap01. phase unwrapping")
+
+	// wrapped ramp
+	n := 50
+	ramp := make([]float64, n)
+	wrapped := make([]float64, n)
+	for i := 0; i < n; i++ {
+		ramp[i] = -2 + 0.7*float64(i)
+		wrapped[i] = math.Atan2(math.Sin(ramp[i]), math.Cos(ramp[i]))
+	}
+	res := Unwrap(wrapped)
+	chk.Array(tst, "Unwrap(wrapped ramp)", 1e-13, res, ramp)
+	for i := 1; i < n; i++ {
+		if res[i] <= res[i-1] {
+			tst.Errorf("unwrapped phase must be monotone: res[%d]=%g ≤ res[%d]=%g\n", i, res[i], i-1, res[i-1])
+			return
+		}
+	}
+
+	// decreasing phase of a delay: exp(-i ω τ)
+	X := make([]complex128, n)
+	for i := 0; i < n; i++ {
+		X[i] = 2 * ExpMix(1.1*float64(i))
+	}
+	res = Unwrap(Phase(X))
+	for i := 0; i < n; i++ {
+		chk.Float64(tst, io.Sf("phase[%d]", i), 1e-13, res[i], -1.1*float64(i))
+	}
+
+	// small arrays
+	chk.Array(tst, "jumps > π", 1e-15, Unwrap([]float64{0, 3.5, 7, -1, 1}), []float64{0, 3.5 - 2*math.Pi, 7 - 4*math.Pi, -1 - 2*math.Pi, 1 - 2*math.Pi})
+	chk.Array(tst, "discont", 1e-15, UnwrapDiscont([]float64{0, 3.5, 8}, 4), []float64{0, 3.5, 8 - 2*math.Pi})
+	chk.Array(tst, "empty", 1e-15, Unwrap(nil), []float64{})
+}
@@ -0,0 +1,136 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// fftBatchTestData returns nrows rows with N complex values each; as real/imag pairs
+func fftBatchTestData(nrows, N int) (rows [][]float64) {
+	x := fftPack(fftTestData(N * nrows))
+	rows = make([][]float64, nrows)
+	for i := 0; i < nrows; i++ {
+		rows[i] = x[2*N*i : 2*N*(i+1)]
+	}
+	return
+}
+
+func TestBatchDft01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("BatchDft01. concurrent versus sequential FFTs")
+
+	nrows, N := 1000, 64
+	for _, nWorkers := range []int{0, 1, 3} {
+		for _, inverse := range []bool{false, true} {
+			rows := fftBatchTestData(nrows, N)
+			seq := fftBatchTestData(nrows, N)
+			err := BatchDft(rows, inverse, nWorkers)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			for i := 0; i < nrows; i++ {
+				err = FourierTransLL(seq[i], inverse)
+				if err != nil {
+					tst.Errorf("%v\n", err)
+					return
+				}
+			}
+			chk.Deep2(tst, io.Sf("nWorkers=%d inverse=%v: batch = sequential", nWorkers, inverse), 1e-17, rows, seq)
+		}
+	}
+}
+
+func TestBatchDft02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("BatchDft02. errors are collected")
+
+	rows := fftBatchTestData(10, 8)
+	rows[3] = rows[3][:12]
+	rows[7] = rows[7][:5]
+	err := BatchDft(rows, false, 4)
+	if err == nil {
+		tst.Errorf("invalid rows should have caused an error\n")
+		return
+	}
+	io.Pforan("%v\n", err)
+	msg := err.Error()
+	if !strings.Contains(msg, "2 of 10 rows") || !strings.Contains(msg, "row 3:") || !strings.Contains(msg, "row 7:") {
+		tst.Errorf("error message is incorrect:\n%v\n", msg)
+		return
+	}
+	if err = BatchDft(rows, false, -1); err == nil {
+		tst.Errorf("nWorkers=-1 should have caused an error\n")
+		return
+	}
+}
+
+func TestBatchDft03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("BatchDft03. cancellation")
+
+	// cancel while transforming the third row
+	nrows, N := 100, 16
+	for _, nWorkers := range []int{1, 4} {
+		rows := fftBatchTestData(nrows, N)
+		orig := fftBatchTestData(nrows, N)
+		ctx, cancel := context.WithCancel(context.Background())
+		var count int32
+		err := batchRun(ctx, nrows, nWorkers, func(i int) error {
+			if atomic.AddInt32(&count, 1) == 3 {
+				cancel()
+			}
+			return FourierTransLL(rows[i], false)
+		})
+		cancel()
+		if err != context.Canceled {
+			tst.Errorf("nWorkers=%d: error should be context.Canceled. err = %v\n", nWorkers, err)
+			return
+		}
+		io.Pforan("nWorkers=%d: number of processed rows = %d\n", nWorkers, count)
+		if nWorkers == 1 {
+			chk.Int(tst, "number of processed rows", int(count), 3)
+		}
+		if int(count) > 3+nWorkers {
+			tst.Errorf("nWorkers=%d: too many rows were processed after cancellation: %d\n", nWorkers, count)
+			return
+		}
+		unchanged := 0
+		for i := 0; i < nrows; i++ {
+			same := true
+			for j := range rows[i] {
+				if rows[i][j] != orig[i][j] {
+					same = false
+				}
+			}
+			if same {
+				unchanged++
+			}
+		}
+		chk.Int(tst, "number of unmodified rows", unchanged, nrows-int(count))
+	}
+
+	// context cancelled before the call
+	rows := fftBatchTestData(10, N)
+	orig := fftBatchTestData(10, N)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := BatchDftContext(ctx, rows, false, 2)
+	if err != context.Canceled {
+		tst.Errorf("error should be context.Canceled. err = %v\n", err)
+		return
+	}
+	chk.Deep2(tst, "rows are not modified", 1e-17, rows, orig)
+}
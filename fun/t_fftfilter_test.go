@@ -0,0 +1,83 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestIdealFilter01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("IdealFilter01. low-pass and high-pass filters")
+
+	// 10 Hz + 200 Hz
+	N, fs := 1024, 1024.0
+	x := make([]float64, N)
+	low := make([]float64, N)
+	high := make([]float64, N)
+	for j := 0; j < N; j++ {
+		t := float64(j) / fs
+		low[j] = math.Sin(2 * math.Pi * 10 * t)
+		high[j] = 0.5 * math.Cos(2*math.Pi*200*t)
+		x[j] = low[j] + high[j]
+	}
+	energy := func(a, b []float64) (e float64) {
+		for j := 0; j < N; j++ {
+			e += (a[j] - b[j]) * (a[j] - b[j])
+		}
+		return
+	}
+	zero := make([]float64, N)
+
+	// low-pass
+	y, err := IdealFilter(x, fs, 50, false)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	eRej := energy(y, low)
+	io.Pforan("low-pass:  energy of rejected component = %g (originally %g)\n", eRej, energy(high, zero))
+	if eRej > 1e-20 {
+		tst.Errorf("the energy of the rejected 200 Hz component is too large: %g\n", eRej)
+		return
+	}
+
+	// high-pass
+	y, err = IdealFilter(x, fs, 50, true)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	eRej = energy(y, high)
+	io.Pforan("high-pass: energy of rejected component = %g (originally %g)\n", eRej, energy(low, zero))
+	if eRej > 1e-20 {
+		tst.Errorf("the energy of the rejected 10 Hz component is too large: %g\n", eRej)
+		return
+	}
+
+	// low-pass + high-pass = identity (cutoff between bins)
+	ylo, _ := IdealFilter(x, fs, 100.5, false)
+	yhi, _ := IdealFilter(x, fs, 100.5, true)
+	for j := 0; j < N; j++ {
+		ylo[j] += yhi[j]
+	}
+	chk.Array(tst, "low + high = x", 1e-13, ylo, x)
+
+	// errors
+	if _, err = IdealFilter(x, 0, 50, false); err == nil {
+		tst.Errorf("fs=0 should have caused an error\n")
+	}
+	if _, err = IdealFilter(x, fs, 600, false); err == nil {
+		tst.Errorf("cutoff > fs/2 should have caused an error\n")
+	}
+	if _, err = IdealFilter(x[:100], fs, 50, false); err == nil {
+		tst.Errorf("N=100 should have caused an error\n")
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// dft2dslow computes the 2D DFT by directly computing the double summation
+func dft2dslow(x [][]complex128) (X [][]complex128) {
+	m, n := len(x), len(x[0])
+	X = make([][]complex128, m)
+	for k := 0; k < m; k++ {
+		X[k] = make([]complex128, n)
+		for p := 0; p < n; p++ {
+			for j := 0; j < m; j++ {
+				for l := 0; l < n; l++ {
+					a := 2.0 * math.Pi * (float64(j*k)/float64(m) + float64(l*p)/float64(n))
+					X[k][p] += x[j][l] * ExpMix(a)
+				}
+			}
+		}
+	}
+	return
+}
+
+// fft2dTestData returns a deterministic matrix with m rows and n columns
+func fft2dTestData(m, n int, shift float64) (x [][]complex128) {
+	x = make([][]complex128, m)
+	for i := 0; i < m; i++ {
+		x[i] = make([]complex128, n)
+		for j := 0; j < n; j++ {
+			a, b := float64(i), float64(j)
+			x[i][j] = complex(math.Sin(0.3*a+1.1*b+shift), math.Cos(a*b+shift)-0.5)
+		}
+	}
+	return
+}
+
+// fft2dCopy returns a copy of x
+func fft2dCopy(x [][]complex128) (y [][]complex128) {
+	y = make([][]complex128, len(x))
+	for i := 0; i < len(x); i++ {
+		y[i] = make([]complex128, len(x[i]))
+		copy(y[i], x[i])
+	}
+	return
+}
+
+func TestDft2d01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Dft2d01. 2D FFT of 8×16 array")
+
+	// forward versus slow method
+	m, n := 8, 16
+	x := fft2dTestData(m, n, 0)
+	X := fft2dCopy(x)
+	err := Dft2d(X, false)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Deep2c(tst, "X = Dft2d(x)", 1e-12, X, dft2dslow(x))
+
+	// round trip
+	err = Dft2d(X, true)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Deep2c(tst, "inverse(Dft2d(x)) = x", 1e-14, X, x)
+
+	// linearity: Dft2d(α⋅x + β⋅y) = α⋅X + β⋅Y
+	α, β := 2.5-1i, -0.75+3i
+	y := fft2dTestData(m, n, 1.5)
+	z := make([][]complex128, m)
+	for i := 0; i < m; i++ {
+		z[i] = make([]complex128, n)
+		for j := 0; j < n; j++ {
+			z[i][j] = α*x[i][j] + β*y[i][j]
+		}
+	}
+	X, Y := fft2dCopy(x), fft2dCopy(y)
+	Dft2d(X, false)
+	Dft2d(Y, false)
+	Dft2d(z, false)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			X[i][j] = α*X[i][j] + β*Y[i][j]
+		}
+	}
+	chk.Deep2c(tst, "Dft2d(α⋅x + β⋅y) = α⋅X + β⋅Y", 1e-12, z, X)
+}
+
+func TestDft2d02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Dft2d02. invalid input")
+
+	if err := Dft2d(fft2dTestData(6, 8, 0), false); err == nil {
+		tst.Errorf("m=6 should have caused an error\n")
+		return
+	}
+	if err := Dft2d(fft2dTestData(8, 12, 0), false); err == nil {
+		tst.Errorf("n=12 should have caused an error\n")
+		return
+	}
+	x := fft2dTestData(4, 8, 0)
+	x[2] = x[2][:4]
+	if err := Dft2d(x, false); err == nil {
+		tst.Errorf("ragged rows should have caused an error\n")
+		return
+	}
+}
+
+func TestDftNd01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("DftNd01. ND FFT versus 2D FFT")
+
+	// 2D array
+	m, n := 8, 16
+	x := fft2dTestData(m, n, 0.5)
+	data := make([]complex128, m*n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			data[i*n+j] = x[i][j]
+		}
+	}
+
+	// transform
+	err := DftNd(data, []int{m, n}, false)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	err = Dft2d(x, false)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	X := make([][]complex128, m)
+	for i := 0; i < m; i++ {
+		X[i] = data[i*n : (i+1)*n]
+	}
+	chk.Deep2c(tst, "DftNd(x) = Dft2d(x)", 1e-13, X, x)
+}
+
+func TestDftNd02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("DftNd02. ND FFT of 4×4×4 cube")
+
+	// data
+	n := 4
+	shape := []int{n, n, n}
+	x := make([]complex128, n*n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			for k := 0; k < n; k++ {
+				a, b, c := float64(i), float64(j), float64(k)
+				x[i*n*n+j*n+k] = complex(math.Sin(a+2*b+3*c), a*b-c)
+			}
+		}
+	}
+	X := make([]complex128, len(x))
+	copy(X, x)
+
+	// check forward transform against direct summation
+	err := DftNd(X, shape, false)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	Xref := make([]complex128, len(x))
+	for p := 0; p < n; p++ {
+		for q := 0; q < n; q++ {
+			for r := 0; r < n; r++ {
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						for k := 0; k < n; k++ {
+							a := 2.0 * math.Pi * float64(i*p+j*q+k*r) / float64(n)
+							Xref[p*n*n+q*n+r] += x[i*n*n+j*n+k] * ExpMix(a)
+						}
+					}
+				}
+			}
+		}
+	}
+	chk.ArrayC(tst, "X = DftNd(x)", 1e-12, X, Xref)
+
+	// round trip
+	err = DftNd(X, shape, true)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.ArrayC(tst, "inverse(DftNd(x)) = x", 1e-14, X, x)
+
+	// errors
+	if err = DftNd(X, []int{4, 4, 3}, false); err == nil {
+		tst.Errorf("shape[2]=3 should have caused an error\n")
+		return
+	}
+	if err = DftNd(X, []int{4, 4}, false); err == nil {
+		tst.Errorf("wrong size should have caused an error\n")
+		return
+	}
+	if err = DftNd(X, nil, false); err == nil {
+		tst.Errorf("empty shape should have caused an error\n")
+		return
+	}
+}
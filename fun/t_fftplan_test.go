@@ -0,0 +1,169 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestFourierPlan01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierPlan01. plan versus FourierTransLL")
+
+	for _, N := range []int{1, 2, 4, 8, 64, 1024} {
+
+		// plan
+		plan, err := NewFourierPlan(N)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+
+		// reuse plan a few times
+		for _, inverse := range []bool{false, true, false} {
+			data := fftPack(fftTestData(N))
+			dataLL := fftPack(fftTestData(N))
+			err = plan.Transform(data, inverse)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			err = FourierTransLL(dataLL, inverse)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			chk.Array(tst, io.Sf("N=%4d inverse=%5v: plan = FourierTransLL", N, inverse), 1e-12, data, dataLL)
+		}
+	}
+}
+
+func TestFourierPlan02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierPlan02. round trip and allocations")
+
+	N := 256
+	plan, err := NewFourierPlan(N)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	orig := fftPack(fftTestData(N))
+	data := make([]float64, len(orig))
+	copy(data, orig)
+	allocs := testing.AllocsPerRun(10, func() {
+		plan.Transform(data, false)
+		plan.Transform(data, true)
+	})
+	chk.Array(tst, "invFFT(FFT(x)) = x", 1e-12, data, orig)
+	chk.Float64(tst, "allocations per run", 1e-17, allocs, 0)
+
+	// errors
+	if _, err = NewFourierPlan(12); err == nil {
+		tst.Errorf("N=12 should have caused an error\n")
+		return
+	}
+	if err = plan.Transform(make([]float64, 2*N+2), false); err == nil {
+		tst.Errorf("wrong length of data should have caused an error\n")
+		return
+	}
+}
+
+func TestRealFFT01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("RealFFT01. RealFFT versus RealFourierTrans")
+
+	for _, N := range []int{1, 2, 4, 8, 64, 1024} {
+
+		// data
+		x := make([]float64, N)
+		for i, v := range fftTestData(N) {
+			x[i] = real(v)
+		}
+
+		// reuse the same object a few times
+		r := NewRealFFT(N)
+		X := make([]complex128, N/2+1)
+		y := make([]float64, N)
+		for trial := 0; trial < 2; trial++ {
+			err := r.Forward(x, X)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			Xref, err := RealFourierTrans(x)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			chk.ArrayC(tst, io.Sf("N=%4d: Forward = RealFourierTrans", N), 1e-12, X, Xref)
+			err = r.Inverse(X, y)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			yref, err := RealFourierTransInv(X, N)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			chk.Array(tst, io.Sf("N=%4d: Inverse = RealFourierTransInv", N), 1e-12, y, yref)
+			chk.Array(tst, io.Sf("N=%4d: Inverse(Forward(x)) = x", N), 1e-12, y, x)
+		}
+	}
+}
+
+func TestRealFFT02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("RealFFT02. allocations and errors")
+
+	N := 256
+	r := NewRealFFT(N)
+	x := make([]float64, N)
+	for i, v := range fftTestData(N) {
+		x[i] = real(v)
+	}
+	X := make([]complex128, N/2+1)
+	y := make([]float64, N)
+	allocs := testing.AllocsPerRun(10, func() {
+		r.Forward(x, X)
+		r.Inverse(X, y)
+	})
+	chk.Array(tst, "Inverse(Forward(x)) = x", 1e-12, y, x)
+	chk.Float64(tst, "allocations per run", 1e-17, allocs, 0)
+
+	// errors
+	if err := r.Forward(make([]float64, N+1), X); err == nil {
+		tst.Errorf("wrong length of x should have caused an error\n")
+		return
+	}
+	if err := r.Forward(x, make([]complex128, N)); err == nil {
+		tst.Errorf("wrong length of out should have caused an error\n")
+		return
+	}
+	if err := r.Inverse(make([]complex128, N), y); err == nil {
+		tst.Errorf("wrong length of spectrum should have caused an error\n")
+		return
+	}
+	if err := r.Inverse(X, make([]float64, N/2)); err == nil {
+		tst.Errorf("wrong length of out should have caused an error\n")
+		return
+	}
+	defer func() {
+		if err := recover(); err == nil {
+			tst.Errorf("n=12 should have caused a panic\n")
+		} else {
+			io.Pf("OK: n=12 caused a panic\n")
+		}
+	}()
+	NewRealFFT(12)
+}
@@ -0,0 +1,72 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestFourierTransFast01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierTransFast01. radix-4 (and radix-2) versus FourierTransLL")
+
+	for _, N := range []int{1, 2, 4, 8, 16, 32, 64, 256, 1024, 2048, 4096} {
+		for _, inverse := range []bool{false, true} {
+			data := fftPack(fftTestData(N))
+			dataLL := fftPack(fftTestData(N))
+			err := FourierTransFast(data, inverse)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			err = FourierTransLL(dataLL, inverse)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			if !inverse { // compare with unitary scaling; i.e. with the magnitude of the input values
+				scale := 1.0 / math.Sqrt(float64(N))
+				for i := range data {
+					data[i] *= scale
+					dataLL[i] *= scale
+				}
+			}
+			chk.Array(tst, io.Sf("N=%4d inverse=%5v: Fast = LL", N, inverse), 1e-12, data, dataLL)
+		}
+	}
+}
+
+func TestFourierTransFast02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierTransFast02. round trip and invalid input")
+
+	x := fftPack(fftTestData(1024))
+	data := make([]float64, len(x))
+	copy(data, x)
+	if err := FourierTransFast(data, false); err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	if err := FourierTransFast(data, true); err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "x = ifft(fft(x))", 1e-14, data, x)
+
+	if err := FourierTransFast(make([]float64, 5), false); err == nil {
+		tst.Errorf("odd length of data should have caused an error\n")
+		return
+	}
+	if err := FourierTransFast(make([]float64, 24), false); err == nil {
+		tst.Errorf("N=12 is not a power of two and should have caused an error\n")
+		return
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestFourierTransSplitRadix01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierTransSplitRadix01. split-radix versus FourierTransLL")
+
+	for _, N := range []int{1, 2, 4, 8, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096} {
+		for _, inverse := range []bool{false, true} {
+			data := fftPack(fftTestData(N))
+			dataLL := fftPack(fftTestData(N))
+			err := FourierTransSplitRadix(data, inverse)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			err = FourierTransLL(dataLL, inverse)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			if !inverse { // compare with unitary scaling; i.e. with the magnitude of the input values
+				scale := 1.0 / math.Sqrt(float64(N))
+				for i := range data {
+					data[i] *= scale
+					dataLL[i] *= scale
+				}
+			}
+			chk.Array(tst, io.Sf("N=%4d inverse=%5v: SplitRadix = LL", N, inverse), 1e-12, data, dataLL)
+		}
+	}
+}
+
+func TestFourierTransSplitRadix02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierTransSplitRadix02. versus slow DFT, round trip and invalid input")
+
+	x := fftTestData(16)
+	data := fftPack(x)
+	if err := FourierTransSplitRadix(data, false); err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "X = dft(x)", 1e-13, data, fftPack(dft1dslow(x)))
+
+	x0 := fftPack(fftTestData(512))
+	data = make([]float64, len(x0))
+	copy(data, x0)
+	if err := FourierTransSplitRadix(data, false); err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	if err := FourierTransSplitRadix(data, true); err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "x = ifft(fft(x))", 1e-14, data, x0)
+
+	allocs := testing.AllocsPerRun(10, func() {
+		FourierTransSplitRadix(data, false)
+		FourierTransSplitRadix(data, true)
+	})
+	if allocs != 0 {
+		tst.Errorf("FourierTransSplitRadix must not allocate memory. allocations per run = %v\n", allocs)
+		return
+	}
+
+	if err := FourierTransSplitRadix(make([]float64, 5), false); err == nil {
+		tst.Errorf("odd length of data should have caused an error\n")
+		return
+	}
+	if err := FourierTransSplitRadix(make([]float64, 12), false); err == nil {
+		tst.Errorf("N=6 is not a power of two and should have caused an error\n")
+		return
+	}
+}
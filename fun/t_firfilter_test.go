@@ -0,0 +1,115 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestFirLowpass01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FirLowpass01. windowed-sinc low-pass filter")
+
+	// design
+	numTaps, cutoff := 101, 0.3
+	win, err := Window("hamming", numTaps)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	h, err := FirLowpass(numTaps, cutoff, win)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	sum := 0.0
+	for k := 0; k < numTaps; k++ {
+		sum += h[k]
+		chk.Float64(tst, io.Sf("linear phase: h[%d] = h[%d]", k, numTaps-1-k), 1e-17, h[k], h[numTaps-1-k])
+	}
+	chk.Float64(tst, "DC gain: Σh", 1e-15, sum, 1)
+
+	// frequency response: bin k corresponds to the normalised frequency 2k/npad
+	npad := 4096
+	hpad, err := ZeroPad(h, npad)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	H, err := RealFourierTrans(hpad)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	maxPass, maxStop := 0.0, 0.0
+	for k, v := range H {
+		f := 2 * float64(k) / float64(npad)
+		if f < cutoff-0.05 {
+			maxPass = math.Max(maxPass, math.Abs(cmplx.Abs(v)-1))
+		}
+		if f > cutoff+0.05 {
+			maxStop = math.Max(maxStop, cmplx.Abs(v))
+		}
+	}
+	io.Pforan("pass band ripple = %g\n", maxPass)
+	io.Pforan("stop band gain   = %g dB\n", 20*math.Log10(maxStop))
+	if maxPass > 0.005 {
+		tst.Errorf("the ripple in the pass band (%g) is too large\n", maxPass)
+		return
+	}
+	if maxStop > math.Pow(10, -50.0/20.0) {
+		tst.Errorf("the stop band attenuation (%g dB) is insufficient\n", 20*math.Log10(maxStop))
+		return
+	}
+
+	// filtering of two tones: 0.1 (kept) and 0.6 (removed) of the Nyquist frequency
+	N := 512
+	x := make([]float64, N)
+	low := make([]float64, N)
+	for j := 0; j < N; j++ {
+		low[j] = math.Sin(math.Pi * 0.1 * float64(j))
+		x[j] = low[j] + math.Sin(math.Pi*0.6*float64(j))
+	}
+	y, err := Convolve(x, h, "same")
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "filtered signal (away from the ends)", 5e-3, y[numTaps:N-numTaps], low[numTaps:N-numTaps])
+}
+
+func TestFirLowpass02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FirLowpass02. rectangular window and invalid input")
+
+	// 3 taps with rectangular window: h ∝ [sinc(-π/2), 1, sinc(π/2)] = [2/π, 1, 2/π]
+	h, err := FirLowpass(3, 0.5, nil)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	den := 1 + 4/math.Pi
+	chk.Array(tst, "h", 1e-15, h, []float64{2 / math.Pi / den, 1 / den, 2 / math.Pi / den})
+
+	if _, err := FirLowpass(4, 0.5, nil); err == nil {
+		tst.Errorf("even number of taps should have caused an error\n")
+		return
+	}
+	if _, err := FirLowpass(5, 1, nil); err == nil {
+		tst.Errorf("cutoff = 1 should have caused an error\n")
+		return
+	}
+	if _, err := FirLowpass(5, 0.5, []float64{1, 1, 1}); err == nil {
+		tst.Errorf("wrong window length should have caused an error\n")
+		return
+	}
+}
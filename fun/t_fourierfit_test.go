@@ -0,0 +1,99 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestFitFourier01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FitFourier01. three sinusoids, irregular time stamps and noise")
+
+	// random time stamps in [0, 10)
+	rng := rand.New(rand.NewSource(4321))
+	n := 400
+	t := make([]float64, n)
+	for i := 0; i < n; i++ {
+		t[i] = 10 * rng.Float64()
+	}
+	sort.Float64s(t)
+
+	// y = 0.7 + Σ A cos(2π f t) + B sin(2π f t) + noise with non-harmonic frequencies
+	freqs := []float64{0.37, 1.1, 2.45}
+	A := []float64{1.5, 0, -0.8}
+	B := []float64{-0.5, 2, 0.3}
+	y := make([]float64, n)
+	for i, ti := range t {
+		y[i] = 0.7
+		for k, f := range freqs {
+			s, c := math.Sincos(2 * math.Pi * f * ti)
+			y[i] += A[k]*c + B[k]*s
+		}
+	}
+
+	// exact data
+	a0, an, bn, err := FitFourier(t, y, freqs)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Float64(tst, "a0 (exact data)", 1e-12, a0, 0.7)
+	chk.Array(tst, "an (exact data)", 1e-12, an, A)
+	chk.Array(tst, "bn (exact data)", 1e-12, bn, B)
+
+	// noisy data
+	for i := range y {
+		y[i] += 0.1 * rng.NormFloat64()
+	}
+	a0, an, bn, err = FitFourier(t, y, freqs)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	io.Pforan("a0 = %v\nan = %v\nbn = %v\n", a0, an, bn)
+	chk.Float64(tst, "a0 (noisy data)", 0.03, a0, 0.7)
+	chk.Array(tst, "an (noisy data)", 0.03, an, A)
+	chk.Array(tst, "bn (noisy data)", 0.03, bn, B)
+}
+
+func TestFitFourier02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FitFourier02. invalid input")
+
+	t := []float64{0, 0.1, 0.3, 0.35, 0.6, 0.8, 0.9}
+	y := make([]float64, len(t))
+	if _, _, _, err := FitFourier(t, y[:3], []float64{1}); err == nil {
+		tst.Errorf("different lengths should have caused an error\n")
+		return
+	}
+	if _, _, _, err := FitFourier(t, y, []float64{1, 0}); err == nil {
+		tst.Errorf("zero frequency should have caused an error\n")
+		return
+	}
+	if _, _, _, err := FitFourier(t, y, []float64{1, 2, 1}); err == nil {
+		tst.Errorf("repeated frequencies should have caused an error\n")
+		return
+	}
+	if _, _, _, err := FitFourier(t, y, []float64{1, 2, 3, 4}); err == nil {
+		tst.Errorf("too few data points should have caused an error\n")
+		return
+	}
+
+	// integer time stamps cannot distinguish f from f+1 (aliasing)
+	ti := []float64{0, 1, 2, 3, 4, 5, 6, 7}
+	if _, _, _, err := FitFourier(ti, make([]float64, 8), []float64{0.25, 1.25}); err == nil {
+		tst.Errorf("aliased frequencies should have caused an error\n")
+		return
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestFourierSeries01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierSeries01. square wave")
+
+	// square wave: +1 in (0, T/2) and -1 in (T/2, T); the mean value is used at the jumps
+	N := 1024
+	x := make([]float64, N)
+	for j := 1; j < N/2; j++ {
+		x[j] = 1
+		x[N/2+j] = -1
+	}
+	a0, an, bn, err := FourierSeries(x)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Int(tst, "len(an)", len(an), N/2)
+	chk.Int(tst, "len(bn)", len(bn), N/2)
+	chk.Float64(tst, "a0", 1e-15, a0, 0)
+
+	// bk = 4/(πk) for odd k and zero for even k; ak = 0
+	for k := 1; k <= 15; k++ {
+		bref := 0.0
+		if k%2 == 1 {
+			bref = 4 / (math.Pi * float64(k))
+		}
+		chk.Float64(tst, io.Sf("a%d", k), 1e-14, an[k-1], 0)
+		chk.Float64(tst, io.Sf("b%d", k), 1e-4, bn[k-1], bref)
+	}
+
+	// reconstruction away from the jumps
+	T := 2.0
+	for _, t := range []float64{0.2, 0.5, 0.8, 1.3, 1.7} {
+		ref := 1.0
+		if t > T/2 {
+			ref = -1
+		}
+		chk.Float64(tst, io.Sf("f(%g)", t), 1e-2, FourierSeriesEval(a0, an, bn, t, T), ref)
+	}
+}
+
+func TestFourierSeries02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FourierSeries02. band-limited signal")
+
+	// f(t) = 1.5 + 2 cos(2πt/T) - 0.5 sin(6πt/T) + 0.25 cos(8πt/T) with N = 8 (Nyquist k = 4)
+	T := 3.0
+	f := func(t float64) float64 {
+		ω := 2 * math.Pi * t / T
+		return 1.5 + 2*math.Cos(ω) - 0.5*math.Sin(3*ω) + 0.25*math.Cos(4*ω)
+	}
+	N := 8
+	x := make([]float64, N)
+	for j := 0; j < N; j++ {
+		x[j] = f(float64(j) * T / float64(N))
+	}
+	a0, an, bn, err := FourierSeries(x)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Float64(tst, "a0", 1e-15, a0, 1.5)
+	chk.Array(tst, "an", 1e-15, an, []float64{2, 0, 0, 0.25})
+	chk.Array(tst, "bn", 1e-15, bn, []float64{0, 0, -0.5, 0})
+
+	// evaluation at arbitrary t
+	for _, t := range []float64{0, 0.1, 0.77, 1.5, 2.9, 4.2} {
+		chk.Float64(tst, io.Sf("f(%g)", t), 1e-14, FourierSeriesEval(a0, an, bn, t, T), f(t))
+	}
+
+	// errors
+	if _, _, _, err = FourierSeries(make([]float64, 6)); err == nil {
+		tst.Errorf("N=6 should have caused an error\n")
+		return
+	}
+	if _, _, _, err = FourierSeries([]float64{1}); err == nil {
+		tst.Errorf("N=1 should have caused an error\n")
+		return
+	}
+}
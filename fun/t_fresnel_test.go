@@ -0,0 +1,61 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestFresnel01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Fresnel01. Fresnel integrals S and C")
+
+	// tabulated values [Abramowitz and Stegun, Table 7.7]
+	s, c := Fresnel(1)
+	chk.Float64(tst, "S(1)", 1e-15, s, 0.43825914739035476607)
+	chk.Float64(tst, "C(1)", 1e-15, c, 0.77989340037682282947)
+	s, c = Fresnel(2)
+	chk.Float64(tst, "S(2)", 1e-15, s, 0.34341567836369824220)
+	chk.Float64(tst, "C(2)", 1e-15, c, 0.48825340607534075450)
+	s, c = Fresnel(0.5)
+	chk.Float64(tst, "S(0.5)", 1e-15, s, 0.06473243285999927761)
+	chk.Float64(tst, "C(0.5)", 1e-15, c, 0.49234422587144639288)
+
+	// limits
+	io.Pl()
+	s, c = Fresnel(0)
+	chk.Float64(tst, "S(0)", 1e-15, s, 0)
+	chk.Float64(tst, "C(0)", 1e-15, c, 0)
+	for _, x := range []float64{1e4, 1e8, math.Inf(1)} {
+		s, c = Fresnel(x)
+		chk.Float64(tst, io.Sf("S(%g)", x), 1.0/(math.Pi*x), s, 0.5)
+		chk.Float64(tst, io.Sf("C(%g)", x), 1.0/(math.Pi*x), c, 0.5)
+	}
+
+	// odd symmetry
+	io.Pl()
+	for _, x := range []float64{0.1, 0.9, 1.5, 1.6, 3, 10.5} {
+		s, c = Fresnel(x)
+		sm, cm := Fresnel(-x)
+		chk.Float64(tst, io.Sf("S(-%g)", x), 1e-15, sm, -s)
+		chk.Float64(tst, io.Sf("C(-%g)", x), 1e-15, cm, -c)
+	}
+
+	// derivatives: S'(x) = sin(π x²/2) and C'(x) = cos(π x²/2), also across x = 1.5 where the
+	// method changes
+	io.Pl()
+	h := 1e-5
+	for _, x := range []float64{0.3, 1.2, 1.5, 1.5 + h/2, 2.7, 6.1} {
+		sp, cp := Fresnel(x + h)
+		sm, cm := Fresnel(x - h)
+		chk.Float64(tst, io.Sf("S'(%g)", x), 1e-8, (sp-sm)/(2*h), math.Sin(math.Pi*x*x/2))
+		chk.Float64(tst, io.Sf("C'(%g)", x), 1e-8, (cp-cm)/(2*h), math.Cos(math.Pi*x*x/2))
+	}
+}
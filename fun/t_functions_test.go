@@ -616,3 +616,50 @@ func TestPow3(tst *testing.T) {
 	chk.Float64(tst, "4³", 1e-15, Pow3(4), 64)
 	chk.Float64(tst, "10³", 1e-15, Pow3(10), 1000)
 }
+
+func TestBetaInc01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("BetaInc01. Regularised incomplete beta function")
+
+	// limits
+	for _, a := range []float64{0.5, 1, 2.5, 10} {
+		for _, b := range []float64{0.3, 1, 4, 20} {
+			chk.Float64(tst, io.Sf("I0(%g,%g)", a, b), 1e-15, BetaInc(0, a, b), 0)
+			chk.Float64(tst, io.Sf("I1(%g,%g)", a, b), 1e-15, BetaInc(1, a, b), 1)
+		}
+	}
+
+	// closed forms
+	io.Pl()
+	for _, x := range []float64{0.01, 0.1, 0.25, 0.5, 0.7, 0.9, 0.999} {
+		chk.Float64(tst, io.Sf("Ix(3,1)     x=%g", x), 1e-15, BetaInc(x, 3, 1), x*x*x)
+		chk.Float64(tst, io.Sf("Ix(1,4)     x=%g", x), 1e-15, BetaInc(x, 1, 4), 1-math.Pow(1-x, 4))
+		chk.Float64(tst, io.Sf("Ix(2,2)     x=%g", x), 1e-15, BetaInc(x, 2, 2), 3*x*x-2*x*x*x)
+		chk.Float64(tst, io.Sf("Ix(0.5,0.5) x=%g", x), 1e-14, BetaInc(x, 0.5, 0.5), 2/math.Pi*math.Asin(math.Sqrt(x)))
+	}
+	for _, a := range []float64{0.2, 1, 7.5, 100} {
+		chk.Float64(tst, io.Sf("I½(%g,%g)", a, a), 1e-15*math.Max(10, a), BetaInc(0.5, a, a), 0.5)
+	}
+
+	// Student-t CDF with ν = 1 (Cauchy): F(t) = 1 - I(ν/(ν+t²))(ν/2, 1/2)/2 = 1/2 + atan(t)/π
+	for _, t := range []float64{0.5, 1, 3, 10} {
+		F := 1 - 0.5*BetaInc(1/(1+t*t), 0.5, 0.5)
+		chk.Float64(tst, io.Sf("Student-t(ν=1) F(%g)", t), 1e-14, F, 0.5+math.Atan(t)/math.Pi)
+	}
+
+	// symmetry: Ix(a,b) = 1 - I₁₋ₓ(b,a)
+	io.Pl()
+	for _, a := range []float64{0.5, 2, 5.5, 30} {
+		for _, b := range []float64{0.7, 3, 12} {
+			for _, x := range []float64{0.05, 0.3, 0.6, 0.95} {
+				chk.Float64(tst, io.Sf("sym a=%g b=%g x=%g", a, b, x), 1e-14, BetaInc(x, a, b), 1-BetaInc(1-x, b, a))
+			}
+		}
+	}
+
+	// invalid arguments
+	if !math.IsNaN(BetaInc(1.5, 1, 1)) || !math.IsNaN(BetaInc(0.5, 0, 1)) {
+		tst.Errorf("invalid arguments should give NaN\n")
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestGamma01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Gamma01. gamma function")
+
+	// Γ(n) = (n-1)!
+	fact := 1.0
+	for n := 1; n <= 25; n++ {
+		chk.AnaNum(tst, io.Sf("Γ(%2d)", n), 1e-14*fact, Gamma(float64(n)), fact, chk.Verbose)
+		fact *= float64(n)
+	}
+
+	// half-integers
+	io.Pl()
+	sqrtπ := math.Sqrt(math.Pi)
+	chk.Float64(tst, "Γ(1/2)", 1e-15, Gamma(0.5), sqrtπ)
+	chk.Float64(tst, "Γ(3/2)", 1e-15, Gamma(1.5), sqrtπ/2)
+	chk.Float64(tst, "Γ(-1/2)", 1e-14, Gamma(-0.5), -2*sqrtπ)
+	chk.Float64(tst, "Γ(-3/2)", 1e-14, Gamma(-1.5), 4*sqrtπ/3)
+
+	// compare with math.Gamma; the relative error of the power t^(x-1/2) grows with x
+	io.Pl()
+	for _, x := range []float64{-7.3, -2.5, -1.01, -0.2, 1e-8, 0.1, 0.75, 1.3, 2.2, 4.9, 10.1, 33.3, 100.5, 170.2} {
+		g := math.Gamma(x)
+		tol := 1e-14 * math.Max(10, math.Abs(x)) * math.Abs(g)
+		chk.AnaNum(tst, io.Sf("Γ(%g)", x), tol, Gamma(x), g, chk.Verbose)
+	}
+
+	// special cases
+	if !math.IsInf(Gamma(0), +1) {
+		tst.Errorf("Γ(0) should be +Inf\n")
+		return
+	}
+	if !math.IsNaN(Gamma(-1)) || !math.IsNaN(Gamma(-4)) {
+		tst.Errorf("Γ(-n) should be NaN\n")
+		return
+	}
+	if !math.IsInf(Gamma(200), +1) {
+		tst.Errorf("Γ(200) should be +Inf\n")
+		return
+	}
+}
+
+func TestLnGamma01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("LnGamma01. logarithm of the gamma function")
+
+	for _, x := range []float64{-7.3, -2.5, -1.01, -0.2, 1e-8, 0.1, 0.5, 1, 1.3, 2, 2.2, 4.9, 10.1, 33.3, 100.5, 170.2, 1e3, 1e6} {
+		ref, sref := math.Lgamma(x)
+		val, sign := LnGamma(x)
+		chk.AnaNum(tst, io.Sf("lnΓ(%g)", x), 1e-13*math.Max(1, math.Abs(ref)), val, ref, chk.Verbose)
+		chk.Int(tst, io.Sf("sign(Γ(%g))", x), sign, sref)
+	}
+
+	// poles
+	for _, x := range []float64{0, -1, -5} {
+		val, _ := LnGamma(x)
+		if !math.IsInf(val, +1) {
+			tst.Errorf("lnΓ(%g) should be +Inf\n", x)
+			return
+		}
+	}
+}
+
+func TestGammaInc01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("GammaInc01. incomplete gamma functions")
+
+	// closed forms: integer a  ⇒  Q(n, x) = exp(-x) Σ_{k<n} xᵏ/k!
+	//               half-integer a  ⇒  P(1/2, x) = erf(√x) and P(3/2, x) = erf(√x) - 2√(x/π) exp(-x)
+	xs := []float64{0.01, 0.3, 1, 2.5, 4, 7.5, 12, 30}
+	for _, x := range xs {
+		term, sum := 1.0, 0.0
+		for n := 1; n <= 12; n++ {
+			sum += term
+			term *= x / float64(n)
+			Q := math.Exp(-x) * sum
+			chk.Float64(tst, io.Sf("Q(%d,%g)", n, x), 1e-14, GammaQ(float64(n), x), Q)
+			chk.Float64(tst, io.Sf("P(%d,%g)", n, x), 1e-14, GammaP(float64(n), x), 1-Q)
+		}
+		s := math.Sqrt(x)
+		chk.Float64(tst, io.Sf("P(0.5,%g)", x), 1e-14, GammaP(0.5, x), math.Erf(s))
+		chk.Float64(tst, io.Sf("Q(0.5,%g)", x), 1e-14*math.Max(1, math.Erfc(s)), GammaQ(0.5, x), math.Erfc(s))
+		chk.Float64(tst, io.Sf("P(1.5,%g)", x), 1e-14, GammaP(1.5, x), math.Erf(s)-2*s/math.SqrtPi*math.Exp(-x))
+		chk.Float64(tst, io.Sf("γ(1,%g)", x), 1e-14, GammaIncLower(1, x), -math.Expm1(-x))
+		chk.Float64(tst, io.Sf("Γ(2,%g)", x), 1e-14, GammaIncUpper(2, x), (1+x)*math.Exp(-x))
+	}
+
+	// small tail: Q(1/2, 30) = erfc(√30) is computed without cancellation
+	chk.Float64(tst, "Q(0.5,30)/erfc(√30)", 1e-13, GammaQ(0.5, 30)/math.Erfc(math.Sqrt(30)), 1)
+
+	// P + Q = 1 and limits
+	io.Pl()
+	for _, a := range []float64{0.1, 0.5, 1, 3.3, 10, 55.5, 200} {
+		for _, x := range []float64{0.01, 0.5, 1, 3, 10, 50, 150, 250} {
+			chk.Float64(tst, io.Sf("P+Q(%g,%g)", a, x), 1e-14, GammaP(a, x)+GammaQ(a, x), 1)
+		}
+		chk.Float64(tst, io.Sf("P(%g,0)", a), 1e-15, GammaP(a, 0), 0)
+		chk.Float64(tst, io.Sf("P(%g,∞)", a), 1e-15, GammaP(a, math.Inf(1)), 1)
+		chk.Float64(tst, io.Sf("Q(%g,0)", a), 1e-15, GammaQ(a, 0), 1)
+	}
+
+	// invalid arguments
+	if !math.IsNaN(GammaP(-1, 1)) || !math.IsNaN(GammaQ(1, -1)) {
+		tst.Errorf("invalid arguments should give NaN\n")
+	}
+}
+
+func TestDigamma01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Digamma01. digamma and trigamma functions")
+
+	// special values
+	γ := 0.57721566490153286060651209008240243104215933593992
+	chk.Float64(tst, "ψ(1)", 1e-15, Digamma(1), -γ)
+	chk.Float64(tst, "ψ(1/2)", 1e-15, Digamma(0.5), -γ-2*math.Ln2)
+	chk.Float64(tst, "ψ(1/4)", 1e-14, Digamma(0.25), -γ-math.Pi/2-3*math.Ln2)
+	chk.Float64(tst, "ψ(10)", 1e-15, Digamma(10), -γ+7129.0/2520.0)
+	chk.Float64(tst, "ψ(-1/2)", 1e-14, Digamma(-0.5), -γ-2*math.Ln2+2)
+	chk.Float64(tst, "ψ(1e6)", 1e-15, Digamma(1e6), math.Log(1e6)-0.5e-6-1/12e12)
+	chk.Float64(tst, "ψ₁(1)", 1e-15, Trigamma(1), math.Pi*math.Pi/6)
+	chk.Float64(tst, "ψ₁(1/2)", 1e-14, Trigamma(0.5), math.Pi*math.Pi/2)
+	chk.Float64(tst, "ψ₁(-1/2)", 1e-14, Trigamma(-0.5), math.Pi*math.Pi/2+4)
+
+	// recurrences: ψ(x+1) = ψ(x) + 1/x and ψ₁(x+1) = ψ₁(x) - 1/x²
+	io.Pl()
+	for _, x := range []float64{-7.3, -2.5, -0.9, 0.01, 0.3, 1.4616321449683623, 2, 5.5, 9.9, 10, 37, 250} {
+		chk.Float64(tst, io.Sf("ψ(%g+1) - ψ(%g) - 1/x", x, x), 1e-13*math.Max(1, 1/math.Abs(x)), Digamma(x+1)-Digamma(x)-1/x, 0)
+		chk.Float64(tst, io.Sf("ψ₁(%g+1) - ψ₁(%g) + 1/x²", x, x), 1e-14*math.Max(1, Trigamma(x)), Trigamma(x+1)-Trigamma(x)+1/(x*x), 0)
+	}
+
+	// derivatives by central differences: ψ = (ln Γ)' and ψ₁ = ψ'
+	io.Pl()
+	h := 1e-5
+	for _, x := range []float64{0.7, 1.5, 3.2, 12.5} {
+		lp, _ := LnGamma(x + h)
+		lm, _ := LnGamma(x - h)
+		chk.Float64(tst, io.Sf("ψ(%g) ≈ Δln Γ", x), 1e-8, Digamma(x), (lp-lm)/(2*h))
+		chk.Float64(tst, io.Sf("ψ₁(%g) ≈ Δψ", x), 1e-8, Trigamma(x), (Digamma(x+h)-Digamma(x-h))/(2*h))
+	}
+
+	// poles
+	for _, x := range []float64{0, -1, -5} {
+		if !math.IsNaN(Digamma(x)) || !math.IsNaN(Trigamma(x)) {
+			tst.Errorf("ψ(%g) and ψ₁(%g) should be NaN\n", x, x)
+		}
+	}
+}
+
+func TestZeta01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Zeta01. Riemann zeta function")
+
+	// closed forms and reference values
+	π := math.Pi
+	for _, c := range []struct {
+		s, ref float64
+	}{
+		{2, π * π / 6},
+		{4, math.Pow(π, 4) / 90},
+		{6, math.Pow(π, 6) / 945},
+		{3, 1.2020569031595942854},
+		{0.5, -1.4603545088095868129},
+		{0, -0.5},
+		{-1, -1.0 / 12},
+		{-3, 1.0 / 120},
+		{-15, 3617.0 / 8160},
+		{-31, 7709321041217.0 / 16320},
+		{60, 1 + math.Pow(2, -60) + math.Pow(3, -60)},
+	} {
+		chk.AnaNum(tst, io.Sf("ζ(%g)", c.s), 1e-14*math.Abs(c.ref), Zeta(c.s), c.ref, chk.Verbose)
+	}
+
+	// trivial zeros
+	io.Pl()
+	for _, s := range []float64{-2, -4, -10, -100} {
+		chk.Float64(tst, io.Sf("ζ(%g)", s), 1e-17, Zeta(s), 0)
+	}
+
+	// near the pole: ζ(s) = 1/(s-1) + γ + O(s-1)
+	γ := 0.57721566490153286061
+	for _, s := range []float64{1 + 1e-4, 1 - 1e-4, 1 + 1e-7} {
+		δ := s - 1 // exact
+		chk.Float64(tst, io.Sf("ζ(1%+g) - 1/δ", δ), 1e-4, Zeta(s)-1/δ, γ)
+	}
+
+	// the critical strip: ζ(s) < 0 for 0 ≤ s < 1 and ζ is decreasing
+	for _, s := range []float64{0.1, 0.3, 0.7, 0.9} {
+		z := Zeta(s)
+		if z >= 0 || Zeta(s+0.05) >= z {
+			tst.Errorf("ζ(%g) = %g is incorrect\n", s, z)
+			return
+		}
+	}
+
+	// special cases
+	if !math.IsInf(Zeta(1), +1) {
+		tst.Errorf("ζ(1) should be +Inf\n")
+		return
+	}
+	chk.Float64(tst, "ζ(+Inf)", 1e-17, Zeta(math.Inf(+1)), 1)
+	if !math.IsNaN(Zeta(math.NaN())) || !math.IsNaN(Zeta(math.Inf(-1))) {
+		tst.Errorf("ζ(NaN) and ζ(-Inf) should be NaN\n")
+		return
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestGoertzel01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Goertzel01. single bins versus Dft")
+
+	N := 64
+	x := make([]float64, N)
+	xc := fftTestData(N)
+	for j := 0; j < N; j++ {
+		x[j] = real(xc[j])
+		xc[j] = complex(x[j], 0)
+	}
+	X, err := Dft(xc, false)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	fs := 8000.0
+	for _, k := range []int{0, 1, 2, 5, 17, 31, 32, 33, 63} {
+		chk.Complex128(tst, io.Sf("Goertzel(x,%2d)", k), 1e-12, Goertzel(x, k), X[k])
+		chk.Complex128(tst, io.Sf("GoertzelFreq(x,%2d fs/N)", k), 1e-12, GoertzelFreq(x, float64(k)*fs/float64(N), fs), X[k])
+	}
+
+	// non-integer bin versus direct sum
+	freq := 1234.5
+	var ref complex128
+	for j := 0; j < N; j++ {
+		ref += complex(x[j], 0) * ExpMix(2*math.Pi*freq*float64(j)/fs)
+	}
+	chk.Complex128(tst, "GoertzelFreq(x,1234.5)", 1e-12, GoertzelFreq(x, freq, fs), ref)
+}
+
+func TestGoertzel02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Goertzel02. DTMF tone detection")
+
+	// key "5": 770 Hz + 1336 Hz
+	fs, N := 8000.0, 205
+	x := make([]float64, N)
+	for j := 0; j < N; j++ {
+		t := float64(j) / fs
+		x[j] = math.Sin(2*math.Pi*770*t) + math.Sin(2*math.Pi*1336*t)
+	}
+	rows := []float64{697, 770, 852, 941}
+	cols := []float64{1209, 1336, 1477}
+	argmax := func(freqs []float64) (imax int) {
+		pmax := 0.0
+		for i, f := range freqs {
+			X := GoertzelFreq(x, f, fs)
+			p := real(X)*real(X) + imag(X)*imag(X)
+			io.Pforan("f = %4g  |X|² = %g\n", f, p)
+			if p > pmax {
+				imax, pmax = i, p
+			}
+		}
+		return
+	}
+	chk.Int(tst, "row", argmax(rows), 1)
+	chk.Int(tst, "col", argmax(cols), 1)
+}
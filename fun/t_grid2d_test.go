@@ -0,0 +1,145 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+// gridValues returns z[i][j] = f(xs[i], ys[j])
+func gridValues(xs, ys []float64, f Sss) (z [][]float64) {
+	z = utl.Alloc(len(xs), len(ys))
+	for i, x := range xs {
+		for j, y := range ys {
+			z[i][j] = f(x, y)
+		}
+	}
+	return
+}
+
+func TestGrid2d01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Grid2d01. exact recovery of bilinear and quadratic surfaces")
+
+	// non-uniform grid
+	xs := []float64{-1, -0.4, 0, 0.3, 1.2, 2}
+	ys := []float64{0, 0.5, 0.7, 1.5, 3}
+
+	// bilinear surface
+	f := func(x, y float64) float64 { return 1 + 2*x - 3*y + 0.5*x*y }
+	o, err := NewGrid2d(xs, ys, gridValues(xs, ys, f))
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for _, x := range utl.LinSpace(-1, 2, 7) {
+		for _, y := range utl.LinSpace(0, 3, 7) {
+			chk.Float64(tst, io.Sf("bilinear(%5.2f,%5.2f)", x, y), 1e-14, o.Bilinear(x, y), f(x, y))
+			chk.Float64(tst, io.Sf("bicubic (%5.2f,%5.2f)", x, y), 1e-14, o.Bicubic(x, y), f(x, y))
+		}
+	}
+
+	// quadratic surface is reproduced by the bicubic interpolant
+	io.Pl()
+	g := func(x, y float64) float64 { return x*x - x*y + 2*y*y - y + 1 }
+	o, err = NewGrid2d(xs, ys, gridValues(xs, ys, g))
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for _, x := range []float64{-0.9, -0.2, 0.1, 0.8, 1.9} {
+		for _, y := range []float64{0.1, 0.6, 1.1, 2.2} {
+			chk.Float64(tst, io.Sf("bicubic (%5.2f,%5.2f)", x, y), 1e-13, o.Bicubic(x, y), g(x, y))
+		}
+	}
+}
+
+func TestGrid2d02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Grid2d02. bicubic versus bilinear on smooth function")
+
+	f := func(x, y float64) float64 { return math.Sin(x) * math.Cos(y) }
+	xs := utl.LinSpace(0, math.Pi, 21)
+	ys := utl.LinSpace(-1, 2, 25)
+	o, err := NewGrid2d(xs, ys, gridValues(xs, ys, f))
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	errLin, errCub := 0.0, 0.0
+	for _, x := range utl.LinSpace(0, math.Pi, 37) {
+		for _, y := range utl.LinSpace(-1, 2, 41) {
+			errLin = math.Max(errLin, math.Abs(o.Bilinear(x, y)-f(x, y)))
+			errCub = math.Max(errCub, math.Abs(o.Bicubic(x, y)-f(x, y)))
+		}
+	}
+	io.Pforan("max error: bilinear = %v  bicubic = %v\n", errLin, errCub)
+	if errCub > 0.1*errLin {
+		tst.Errorf("bicubic error (%g) should be much smaller than bilinear error (%g)\n", errCub, errLin)
+		return
+	}
+
+	// values at nodes
+	for i, x := range xs {
+		for j, y := range ys {
+			chk.Float64(tst, io.Sf("bicubic(x%d,y%d)", i, j), 1e-15, o.Bicubic(x, y), o.Z[i][j])
+		}
+	}
+
+	// clamp
+	chk.Float64(tst, "clamped bilinear", 1e-15, o.Bilinear(-1, 5), f(0, 2))
+	chk.Float64(tst, "clamped bicubic", 1e-15, o.Bicubic(4, -3), f(math.Pi, -1))
+}
+
+func TestGrid2d03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Grid2d03. invalid input and out-of-domain error")
+
+	xs := []float64{0, 1, 2}
+	ys := []float64{0, 1}
+	if _, err := NewGrid2d([]float64{0, 1, 1}, ys, utl.Alloc(3, 2)); err == nil {
+		tst.Errorf("non-increasing x should have caused an error\n")
+		return
+	}
+	if _, err := NewGrid2d(xs, []float64{0}, utl.Alloc(3, 1)); err == nil {
+		tst.Errorf("single y value should have caused an error\n")
+		return
+	}
+	if _, err := NewGrid2d(xs, ys, utl.Alloc(2, 2)); err == nil {
+		tst.Errorf("wrong number of rows should have caused an error\n")
+		return
+	}
+	if _, err := NewGrid2d(xs, ys, [][]float64{{0, 1}, {0, 1}, {0}}); err == nil {
+		tst.Errorf("wrong number of columns should have caused an error\n")
+		return
+	}
+
+	// out-of-domain error
+	o, err := NewGrid2d(xs, ys, utl.Alloc(3, 2))
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	o.OutOfDomain = "error"
+	chk.Float64(tst, "inside", 1e-17, o.Bilinear(2, 1), 0)
+	defer func() {
+		if err := recover(); err != nil {
+			if chk.Verbose {
+				io.Pf("OK, caught the following message:\n\n\t%v\n", err)
+			}
+		} else {
+			tst.Errorf("\n\tTEST FAILED. Bicubic should have panicked\n")
+		}
+	}()
+	o.Bicubic(2.1, 0.5)
+}
@@ -0,0 +1,103 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestFundamentalFreq01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FundamentalFreq01. pitch of sinusoids")
+
+	fs, N := 8000.0, 2048
+	for _, f := range []float64{100, 440, 1000} {
+
+		// pure tone and tone with harmonics
+		x := make([]float64, N)
+		y := make([]float64, N)
+		for j := 0; j < N; j++ {
+			t := float64(j) / fs
+			x[j] = math.Sin(2 * math.Pi * f * t)
+			y[j] = x[j] + 0.5*math.Sin(4*math.Pi*f*t+0.3) + 0.25*math.Sin(6*math.Pi*f*t+1.1)
+		}
+		for i, s := range [][]float64{x, y} {
+			f0, err := FundamentalFreq(s, fs)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			io.Pforan("f = %4g  signal %d  f0 = %g\n", f, i, f0)
+			chk.Float64(tst, io.Sf("f0(%g)", f), 0.01*f, f0, f)
+		}
+	}
+
+	// errors
+	if _, err := FundamentalFreq([]float64{1, 2}, fs); err == nil {
+		tst.Errorf("short signal should have caused an error\n")
+	}
+	if _, err := FundamentalFreq(make([]float64, 100), fs); err == nil {
+		tst.Errorf("zero signal should have caused an error\n")
+	}
+	if _, err := FundamentalFreq(make([]float64, 100), 0); err == nil {
+		tst.Errorf("fs=0 should have caused an error\n")
+	}
+}
+
+func TestTHD01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("THD01. total harmonic distortion")
+
+	// fundamental plus harmonics with known amplitudes
+	fs, N, f := 1024.0, 1024, 50.0
+	amps := []float64{1, 0.1, 0.05, 0.02}
+	x := make([]float64, N)
+	for j := 0; j < N; j++ {
+		t := float64(j) / fs
+		for h, A := range amps {
+			x[j] += A * math.Sin(2*math.Pi*float64(h+1)*f*t+0.2*float64(h))
+		}
+	}
+	for n := 1; n <= 5; n++ {
+		sum := 0.0
+		for h := 1; h < len(amps) && h <= n; h++ {
+			sum += amps[h] * amps[h]
+		}
+		thd, err := THD(x, fs, f, n)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Float64(tst, io.Sf("THD with %d harmonics", n), 1e-13, thd, math.Sqrt(sum)/amps[0])
+	}
+
+	// harmonics above Nyquist are ignored
+	thd, err := THD(x, fs, 200, 10) // only 400 Hz (absent) is considered
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Float64(tst, "THD at 200 Hz", 1e-12, thd, 0)
+
+	// errors
+	if _, err = THD(x, fs, 0, 3); err == nil {
+		tst.Errorf("fundamental=0 should have caused an error\n")
+	}
+	if _, err = THD(x, fs, f, 0); err == nil {
+		tst.Errorf("nHarmonics=0 should have caused an error\n")
+	}
+	if _, err = THD(x[:1000], fs, f, 3); err == nil {
+		tst.Errorf("N=1000 should have caused an error\n")
+	}
+	if _, err = THD(make([]float64, 8), fs, f, 3); err == nil {
+		tst.Errorf("zero fundamental should have caused an error\n")
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+func TestHermitePhys01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("HermitePhys01. Hermite polynomials (physicists')")
+
+	// closed forms
+	for _, x := range utl.LinSpace(-3, 3, 13) {
+		chk.Float64(tst, io.Sf("H0(%g)", x), 1e-15, HermitePhys(0, x), 1)
+		chk.Float64(tst, io.Sf("H1(%g)", x), 1e-15, HermitePhys(1, x), 2*x)
+		chk.Float64(tst, io.Sf("H2(%g)", x), 1e-14, HermitePhys(2, x), 4*x*x-2)
+		chk.Float64(tst, io.Sf("H3(%g)", x), 1e-13, HermitePhys(3, x), 8*x*x*x-12*x)
+		chk.Float64(tst, io.Sf("H4(%g)", x), 1e-12, HermitePhys(4, x), 16*math.Pow(x, 4)-48*x*x+12)
+	}
+
+	// orthogonality: ∫ exp(-x²) Hm Hn dx = √π 2ⁿ n! δmn; the trapezoidal rule converges
+	// exponentially for smooth integrands that decay quickly
+	io.Pl()
+	h := 0.05
+	xs := utl.LinSpace(-15, 15, int(30/h)+1)
+	nrm := func(n int) float64 { return math.Sqrt(math.SqrtPi * math.Pow(2, float64(n)) * Gamma(float64(n+1))) }
+	for m := 0; m <= 8; m++ {
+		for n := 0; n <= 8; n++ {
+			res := 0.0
+			for _, x := range xs {
+				res += h * math.Exp(-x*x) * HermitePhys(m, x) * HermitePhys(n, x)
+			}
+			cor := 0.0
+			if m == n {
+				cor = 1
+			}
+			chk.Float64(tst, io.Sf("∫w H%d H%d / (‖H%d‖‖H%d‖)", m, n, m, n), 1e-14, res/(nrm(m)*nrm(n)), cor)
+		}
+	}
+}
+
+func TestLaguerre01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Laguerre01. Laguerre polynomials")
+
+	// closed forms
+	for _, x := range utl.LinSpace(0, 10, 11) {
+		chk.Float64(tst, io.Sf("L0(%g)", x), 1e-15, Laguerre(0, x), 1)
+		chk.Float64(tst, io.Sf("L1(%g)", x), 1e-15, Laguerre(1, x), 1-x)
+		chk.Float64(tst, io.Sf("L2(%g)", x), 1e-14, Laguerre(2, x), (x*x-4*x+2)/2)
+		chk.Float64(tst, io.Sf("L3(%g)", x), 1e-13, Laguerre(3, x), (-x*x*x+9*x*x-18*x+6)/6)
+	}
+
+	// orthonormality: ∫ exp(-x) Lm Ln dx = δmn; with x = exp(s), the integrand decays
+	// exponentially at both ends and the trapezoidal rule converges quickly
+	io.Pl()
+	h := 0.02
+	ss := utl.LinSpace(-40, 5, int(45/h)+1)
+	for m := 0; m <= 8; m++ {
+		for n := 0; n <= 8; n++ {
+			res := 0.0
+			for _, s := range ss {
+				x := math.Exp(s)
+				res += h * x * math.Exp(-x) * Laguerre(m, x) * Laguerre(n, x)
+			}
+			cor := 0.0
+			if m == n {
+				cor = 1
+			}
+			chk.Float64(tst, io.Sf("∫w L%d L%d", m, n), 1e-12, res, cor)
+		}
+	}
+
+	// invalid degree
+	defer func() {
+		if err := recover(); err != nil {
+			io.Pf("OK: negative degree caused panic\n")
+		} else {
+			tst.Errorf("negative degree should cause panic\n")
+		}
+	}()
+	Laguerre(-1, 0.5)
+}
@@ -0,0 +1,63 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+func TestHilbert01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Hilbert01. analytic signal and envelope")
+
+	// amplitude-modulated signal: x(t) = A(t) cos(ω t)
+	N := 512
+	x := make([]float64, N)
+	A := make([]float64, N)
+	for k := 0; k < N; k++ {
+		t := float64(k) / float64(N) // one period of the modulation
+		A[k] = 1.0 + 0.5*math.Cos(2*math.Pi*t)
+		x[k] = A[k] * math.Cos(2*math.Pi*40*t)
+	}
+
+	// analytic signal: real part is x and imaginary part is the Hilbert transform of x
+	z, err := Hilbert(x)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	re := make([]float64, N)
+	for k := 0; k < N; k++ {
+		re[k] = real(z[k])
+	}
+	chk.Array(tst, "real(z) = x", 1e-13, re, x)
+
+	// envelope
+	env, err := Envelope(x)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "envelope = A(t)", 1e-12, env, A)
+
+	// H{cos} = sin
+	for k := 0; k < N; k++ {
+		x[k] = math.Cos(2 * math.Pi * 5 * float64(k) / float64(N))
+	}
+	z, _ = Hilbert(x)
+	for k := 0; k < N; k++ {
+		chk.Float64(tst, "imag(z) = sin", 1e-13, imag(z[k]), math.Sin(2*math.Pi*5*float64(k)/float64(N)))
+	}
+
+	// error
+	if _, err = Hilbert(make([]float64, 10)); err == nil {
+		tst.Errorf("N=10 should have caused an error\n")
+		return
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+// iirGainDB computes the magnitude (in dB) of the frequency response of the filter (b, a) at f
+func iirGainDB(b, a []float64, f, fs float64) float64 {
+	z := cmplx.Exp(complex(0, -2*math.Pi*f/fs)) // z⁻¹
+	var num, den complex128
+	zk := complex(1, 0)
+	for k := 0; k < len(b) || k < len(a); k++ {
+		if k < len(b) {
+			num += complex(b[k], 0) * zk
+		}
+		if k < len(a) {
+			den += complex(a[k], 0) * zk
+		}
+		zk *= z
+	}
+	return 20 * math.Log10(cmplx.Abs(num/den))
+}
+
+func TestButterworth01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Butterworth01. coefficients and magnitude response")
+
+	// scipy.signal.butter(2, 0.2)
+	b, a, err := Butterworth(2, 100, 1000)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "b", 1e-15, b, []float64{0.0674552738890719, 0.1349105477781438, 0.0674552738890719})
+	chk.Array(tst, "a", 1e-15, a, []float64{1, -1.1429805025399011, 0.4128015980961886})
+
+	// -3 dB at cutoff and 6⋅n dB/octave roll-off. The exact response of the digital filter is
+	// |H(f)|² = 1 / (1 + (tan(πf/fs) / tan(πfc/fs))²ⁿ); thus the octaves are measured with the
+	// prewarped frequency; i.e. from f1 to f2 such that tan(πf2/fs) = 2 tan(πf1/fs)
+	fs, fc := 1000.0, 10.0
+	f1 := fs / math.Pi * math.Atan(16*math.Tan(math.Pi*fc/fs))
+	f2 := fs / math.Pi * math.Atan(32*math.Tan(math.Pi*fc/fs))
+	exact := func(n int, f float64) float64 {
+		r := math.Tan(math.Pi*f/fs) / math.Tan(math.Pi*fc/fs)
+		return -10 * math.Log10(1+math.Pow(r, float64(2*n)))
+	}
+	for _, n := range []int{1, 2, 3, 4, 5, 6} {
+		b, a, err = Butterworth(n, fc, fs)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Float64(tst, io.Sf("n=%d: gain at f=0        ", n), 1e-6, iirGainDB(b, a, 0, fs), 0)
+		chk.Float64(tst, io.Sf("n=%d: gain at f=cutoff   ", n), 1e-6, iirGainDB(b, a, fc, fs), -10*math.Log10(2))
+		for _, f := range []float64{5, 20, 40, 80, f1, f2} {
+			chk.Float64(tst, io.Sf("n=%d: gain at f=%5.1f    ", n, f), 1e-6, iirGainDB(b, a, f, fs), exact(n, f))
+		}
+		rolloff := iirGainDB(b, a, f2, fs) - iirGainDB(b, a, f1, fs)
+		chk.Float64(tst, io.Sf("n=%d: roll-off per octave", n), 0.1, rolloff, -20*math.Log10(2)*float64(n))
+	}
+
+	// invalid input
+	if _, _, err = Butterworth(0, 10, 100); err == nil {
+		tst.Errorf("order = 0 should have caused an error\n")
+		return
+	}
+	if _, _, err = Butterworth(2, 50, 100); err == nil {
+		tst.Errorf("cutoff = fs/2 should have caused an error\n")
+		return
+	}
+}
+
+func TestBilinear01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Bilinear01. first- and second-order analog low-pass filters")
+
+	// first-order low-pass H(s) = Ωc / (s + Ωc)
+	fs, fc := 1000.0, 200.0
+	Ωc := 2 * math.Pi * fc
+
+	// without prewarping, the cutoff is shifted to (fs/π) atan(Ωc / (2 fs))
+	b, a, err := Bilinear([]float64{Ωc}, []float64{1, Ωc}, fs)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	fd := fs / math.Pi * math.Atan(Ωc/(2*fs))
+	io.Pforan("digital cutoff without prewarping = %g Hz\n", fd)
+	chk.Float64(tst, "no prewarp: gain at f = 0 ", 1e-13, iirGainDB(b, a, 0, fs), 0)
+	chk.Float64(tst, "no prewarp: gain at fd    ", 1e-12, iirGainDB(b, a, fd, fs), -10*math.Log10(2))
+	if math.Abs(iirGainDB(b, a, fc, fs)+10*math.Log10(2)) < 0.5 {
+		tst.Errorf("without prewarping, the gain at fc should not be -3 dB\n")
+		return
+	}
+
+	// with prewarping at fc, the cutoff is preserved; same as Butterworth of order 1
+	b, a, err = Bilinear([]float64{Ωc}, []float64{1, Ωc}, fs, fc)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Float64(tst, "prewarp: gain at fc       ", 1e-12, iirGainDB(b, a, fc, fs), -10*math.Log10(2))
+	bb, aa, _ := Butterworth(1, fc, fs)
+	chk.Array(tst, "prewarp: b = Butterworth", 1e-14, b, bb)
+	chk.Array(tst, "prewarp: a = Butterworth", 1e-14, a, aa)
+
+	// second-order Butterworth prototype Ωc² / (s² + √2 Ωc s + Ωc²)
+	b, a, err = Bilinear([]float64{Ωc * Ωc}, []float64{1, math.Sqrt2 * Ωc, Ωc * Ωc}, fs, fc)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	bb, aa, _ = Butterworth(2, fc, fs)
+	chk.Array(tst, "2nd order: b = Butterworth", 1e-14, b, bb)
+	chk.Array(tst, "2nd order: a = Butterworth", 1e-14, a, aa)
+
+	// errors
+	if _, _, err = Bilinear([]float64{1}, []float64{1, 1}, fs, fs); err == nil {
+		tst.Errorf("prewarping at fs should have caused an error\n")
+	}
+	if _, _, err = Bilinear([]float64{1}, nil, fs); err == nil {
+		tst.Errorf("empty denominator should have caused an error\n")
+	}
+}
+
+func TestFilter01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Filter01. direct form II")
+
+	// first-order recursion y[j] = x[j] + 0.5 y[j-1] ⇒ impulse response 0.5ʲ
+	x := []float64{1, 0, 0, 0, 0}
+	chk.Array(tst, "impulse response", 1e-17, Filter([]float64{1}, []float64{1, -0.5}, x), []float64{1, 0.5, 0.25, 0.125, 0.0625})
+
+	// FIR filter (a = [2] normalises b) equals the convolution
+	y := Filter([]float64{2, 4, 6}, []float64{2}, []float64{1, 2, 3, 4})
+	c, _ := Convolve([]float64{1, 2, 3, 4}, []float64{1, 2, 3}, "full")
+	chk.Array(tst, "FIR = convolution", 1e-14, y, c[:4])
+
+	// sinusoid at the cutoff frequency: amplitude 1/√2 in steady state
+	fs, fc := 1000.0, 50.0
+	b, a, err := Butterworth(4, fc, fs)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	N := 2000
+	x = make([]float64, N)
+	for j := 0; j < N; j++ {
+		x[j] = math.Sin(2 * math.Pi * fc * float64(j) / fs)
+	}
+	y = Filter(b, a, x)
+	amp := 0.0
+	for j := N / 2; j < N; j++ {
+		amp = math.Max(amp, math.Abs(y[j]))
+	}
+	chk.Float64(tst, "steady-state amplitude at cutoff", 1e-3, amp, 1/math.Sqrt2)
+}
+
+func TestGroupDelay01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("GroupDelay01. linear-phase FIR and first-order IIR filters")
+
+	// symmetric FIR without zeros on the unit circle: constant delay of 2 samples
+	freqs, gd, err := GroupDelay([]float64{1, 2, 5, 2, 1}, []float64{1}, 64)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Int(tst, "len(freqs)", len(freqs), 64)
+	chk.Float64(tst, "freqs[1]", 1e-17, freqs[1], math.Pi/64)
+	chk.Array(tst, "gd (5 taps)", 1e-13, gd, utl.Vals(64, 2))
+
+	// windowed-sinc low-pass filter: (numTaps-1)/2 in the pass band
+	numTaps := 31
+	win, _ := Window("hamming", numTaps)
+	h, err := FirLowpass(numTaps, 0.4, win)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	freqs, gd, err = GroupDelay(h, []float64{1}, 256)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for j, ω := range freqs {
+		if ω < 0.3*math.Pi {
+			chk.Float64(tst, io.Sf("ω=%.4f: gd", ω), 1e-11, gd[j], float64(numTaps-1)/2)
+		}
+	}
+
+	// first-order IIR: H = 1 / (1 - p z⁻¹) ⇒ τ = p (cos ω - p) / (1 - 2 p cos ω + p²)
+	p := 0.8
+	freqs, gd, err = GroupDelay([]float64{1}, []float64{1, -p}, 32)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for j, ω := range freqs {
+		c := math.Cos(ω)
+		chk.Float64(tst, io.Sf("IIR: ω=%.4f: gd", ω), 1e-13, gd[j], p*(c-p)/(1-2*p*c+p*p))
+	}
+
+	// error
+	if _, _, err = GroupDelay([]float64{1}, []float64{1}, 100); err == nil {
+		tst.Errorf("nFreqs=100 should have caused an error\n")
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+func TestJacobiElliptic01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("JacobiElliptic01. Jacobi elliptic functions sn, cn, dn")
+
+	// identities
+	for _, m := range []float64{1e-20, 0.1, 0.5, 0.9, 0.99, 1 - 1e-12, 1} {
+		for _, u := range utl.LinSpace(-10, 10, 41) {
+			sn, cn, dn := JacobiElliptic(u, m)
+			chk.Float64(tst, io.Sf("sn²+cn²   (u=%g,m=%g)", u, m), 1e-15, sn*sn+cn*cn, 1)
+			chk.Float64(tst, io.Sf("dn²+m⋅sn² (u=%g,m=%g)", u, m), 1e-15, dn*dn+m*sn*sn, 1)
+		}
+	}
+
+	// m = 0 and m = 1
+	io.Pl()
+	for _, u := range []float64{-3, -0.5, 0, 0.7, 2, 5} {
+		sn, cn, dn := JacobiElliptic(u, 0)
+		chk.Float64(tst, io.Sf("sn(%g|0)", u), 1e-15, sn, math.Sin(u))
+		chk.Float64(tst, io.Sf("cn(%g|0)", u), 1e-15, cn, math.Cos(u))
+		chk.Float64(tst, io.Sf("dn(%g|0)", u), 1e-15, dn, 1)
+		sn, cn, dn = JacobiElliptic(u, 1e-14)
+		chk.Float64(tst, io.Sf("sn(%g|1e-14)", u), 1e-13, sn, math.Sin(u))
+		sn, cn, dn = JacobiElliptic(u, 1-1e-14)
+		chk.Float64(tst, io.Sf("sn(%g|1-1e-14)", u), 1e-12, sn, math.Tanh(u))
+		chk.Float64(tst, io.Sf("cn(%g|1-1e-14)", u), 1e-12, cn, 1/math.Cosh(u))
+		chk.Float64(tst, io.Sf("dn(%g|1-1e-14)", u), 1e-12, dn, 1/math.Cosh(u))
+	}
+
+	// quarter period: sn(K) = 1, cn(K) = 0, dn(K) = √(1-m); and period 4K of sn
+	io.Pl()
+	for _, m := range []float64{0.2, 0.5, 0.8, 0.999} {
+		K := EllipticK(m)
+		sn, cn, dn := JacobiElliptic(K, m)
+		chk.Float64(tst, io.Sf("sn(K|%g)", m), 1e-14, sn, 1)
+		chk.Float64(tst, io.Sf("cn(K|%g)", m), 1e-14, cn, 0)
+		chk.Float64(tst, io.Sf("dn(K|%g)", m), 1e-14, dn, math.Sqrt(1-m))
+		sa, _, _ := JacobiElliptic(0.3, m)
+		sb, _, _ := JacobiElliptic(0.3+4*K, m)
+		chk.Float64(tst, io.Sf("sn(0.3+4K|%g)", m), 1e-13, sb, sa)
+	}
+
+	// inverse: F(am(u|m), k) = u with F the incomplete elliptic integral of the first kind
+	io.Pl()
+	for _, m := range []float64{0.1, 0.5, 0.9} {
+		K := EllipticK(m)
+		for _, u := range []float64{0.1 * K, 0.5 * K, 0.9 * K} {
+			sn, _, _ := JacobiElliptic(u, m)
+			chk.Float64(tst, io.Sf("F(am(%.4f|%g))", u, m), 1e-14, Elliptic1(math.Asin(sn), math.Sqrt(m)), u)
+		}
+	}
+
+	// invalid m
+	sn, cn, dn := JacobiElliptic(0.5, 1.5)
+	if !math.IsNaN(sn) || !math.IsNaN(cn) || !math.IsNaN(dn) {
+		tst.Errorf("m > 1 should give NaN\n")
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestLambertW01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("LambertW01. Lambert W function")
+
+	// special values
+	check := func(name string, tol float64, f func(float64) (float64, error), x, ref float64) {
+		w, err := f(x)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Float64(tst, name, tol, w, ref)
+	}
+	check("W0(0)", 1e-15, LambertW0, 0, 0)
+	check("W0(e)", 1e-15, LambertW0, math.E, 1)
+	check("W0(1)", 1e-15, LambertW0, 1, 0.56714329040978387300) // omega constant
+	check("W0(-1/e)", 1e-15, LambertW0, -1/math.E, -1)
+	check("W0(2e²)", 1e-15, LambertW0, 2*math.E*math.E, 2)
+	check("W0(-ln2/2)", 1e-15, LambertW0, -math.Ln2/2, -math.Ln2)
+	check("W-1(-1/e)", 1e-15, LambertWm1, -1/math.E, -1)
+	check("W-1(-ln2/2)", 1e-15, LambertWm1, -math.Ln2/2, -2*math.Ln2)
+	check("W-1(-2e⁻²)", 1e-15, LambertWm1, -2*math.Exp(-2), -2)
+
+	// w exp(w) = x; the tolerance accounts for the condition number |d ln(x) / d ln(w)| = |1 + w|
+	io.Pl()
+	for _, x := range []float64{-0.3678, -0.36, -0.3, -0.25, -0.1, -1e-5, 1e-10, 0.5, 2.9, 3, 10, 1e3, 1e10, 1e100, 1e300} {
+		w, err := LambertW0(x)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Float64(tst, io.Sf("W0(%g) exp(W0) / x", x), 1e-15*(1+math.Abs(w)), w*math.Exp(w)/x, 1)
+	}
+	for _, x := range []float64{-0.3678, -0.36, -0.3, -0.25, -0.2, -0.1, -1e-5, -1e-100, -1e-300} {
+		w, err := LambertWm1(x)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		if w > -1 {
+			tst.Errorf("W-1(%g) = %g should be ≤ -1\n", x, w)
+		}
+		chk.Float64(tst, io.Sf("W-1(%g) exp(W-1) / x", x), 1e-15*(1+math.Abs(w)), w*math.Exp(w)/x, 1)
+	}
+
+	// errors
+	if _, err := LambertW0(-0.5); err == nil {
+		tst.Errorf("W0(-0.5) should have failed\n")
+	}
+	if _, err := LambertWm1(-0.5); err == nil {
+		tst.Errorf("W-1(-0.5) should have failed\n")
+	}
+	if _, err := LambertWm1(0.1); err == nil {
+		tst.Errorf("W-1(0.1) should have failed\n")
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+func TestLegendreAssoc01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("LegendreAssoc01. Associated Legendre functions")
+
+	// closed forms
+	for _, x := range utl.LinSpace(-1, 1, 11) {
+		s := math.Sqrt(1 - x*x)
+		chk.Float64(tst, io.Sf("P00(%g)", x), 1e-15, LegendreAssoc(0, 0, x), 1)
+		chk.Float64(tst, io.Sf("P10(%g)", x), 1e-15, LegendreAssoc(1, 0, x), x)
+		chk.Float64(tst, io.Sf("P11(%g)", x), 1e-15, LegendreAssoc(1, 1, x), -s)
+		chk.Float64(tst, io.Sf("P20(%g)", x), 1e-15, LegendreAssoc(2, 0, x), (3*x*x-1)/2)
+		chk.Float64(tst, io.Sf("P21(%g)", x), 1e-15, LegendreAssoc(2, 1, x), -3*x*s)
+		chk.Float64(tst, io.Sf("P22(%g)", x), 1e-15, LegendreAssoc(2, 2, x), 3*(1-x*x))
+		chk.Float64(tst, io.Sf("P30(%g)", x), 1e-15, LegendreAssoc(3, 0, x), (5*x*x*x-3*x)/2)
+		chk.Float64(tst, io.Sf("P31(%g)", x), 1e-14, LegendreAssoc(3, 1, x), -1.5*(5*x*x-1)*s)
+		chk.Float64(tst, io.Sf("P32(%g)", x), 1e-14, LegendreAssoc(3, 2, x), 15*x*(1-x*x))
+		chk.Float64(tst, io.Sf("P33(%g)", x), 1e-14, LegendreAssoc(3, 3, x), -15*s*s*s)
+		chk.Float64(tst, io.Sf("P42(%g)", x), 1e-13, LegendreAssoc(4, 2, x), 7.5*(7*x*x-1)*(1-x*x))
+	}
+
+	// special values
+	chk.Float64(tst, "P(5,6)", 1e-15, LegendreAssoc(5, 6, 0.3), 0)
+	if !math.IsNaN(LegendreAssoc(2, 1, 1.5)) {
+		tst.Errorf("|x| > 1 should give NaN\n")
+	}
+
+	// Gauss-Legendre quadrature
+	N := 10
+	X, W := gaussLegendreRule(N)
+	sumW := 0.0
+	for _, w := range W {
+		sumW += w
+	}
+	chk.Float64(tst, "Σw", 1e-14, sumW, 2)
+
+	// orthogonality:  ∫ Pₗᵐ Pₖᵐ dx = δₗₖ 2 (l+m)! / ((2l+1) (l-m)!)  since Pₗᵐ Pₖᵐ is a polynomial
+	io.Pl()
+	for m := 0; m <= 3; m++ {
+		for l := m; l <= 6; l++ {
+			for k := m; k <= 6; k++ {
+				res := 0.0
+				for i := 0; i < N; i++ {
+					res += W[i] * LegendreAssoc(l, m, X[i]) * LegendreAssoc(k, m, X[i])
+				}
+				cor := 0.0
+				if l == k {
+					cor = 2.0 * Gamma(float64(l+m+1)) / (Gamma(float64(l-m+1)) * float64(2*l+1))
+				}
+				chk.Float64(tst, io.Sf("∫P(%d,%d)P(%d,%d)", l, m, k, m), 1e-12*(1+cor), res, cor)
+			}
+		}
+	}
+}
+
+// gaussLegendreRule computes the N nodes and weights of the Gauss-Legendre quadrature (exact for
+// polynomials of degree ≤ 2N-1). The nodes are the roots of P_N found by Newton's method with
+// P_N' = N (x P_N - P_(N-1)) / (x² - 1)
+func gaussLegendreRule(N int) (X, W []float64) {
+	X = make([]float64, N)
+	W = make([]float64, N)
+	for i := 0; i < N; i++ {
+		x := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(N) + 0.5))
+		var dp float64
+		for it := 0; it < 100; it++ {
+			p := LegendreAssoc(N, 0, x)
+			dp = float64(N) * (x*p - LegendreAssoc(N-1, 0, x)) / (x*x - 1)
+			dx := p / dp
+			x -= dx
+			if math.Abs(dx) < 1e-15 {
+				break
+			}
+		}
+		dp = float64(N) * (x*LegendreAssoc(N, 0, x) - LegendreAssoc(N-1, 0, x)) / (x*x - 1)
+		X[i], W[i] = x, 2.0/((1-x*x)*dp*dp)
+	}
+	return
+}
+
+func TestSphericalHarmonic01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("SphericalHarmonic01. Real spherical harmonics")
+
+	// closed forms
+	c0 := math.Sqrt(1.0 / (4.0 * math.Pi))
+	c1 := math.Sqrt(3.0 / (4.0 * math.Pi))
+	c2 := math.Sqrt(15.0 / (4.0 * math.Pi))
+	for _, θ := range []float64{0, 0.3, 1.2, 2.5, math.Pi} {
+		for _, φ := range []float64{0, 0.7, 2.0, 4.5} {
+			x := math.Sin(θ) * math.Cos(φ)
+			y := math.Sin(θ) * math.Sin(φ)
+			z := math.Cos(θ)
+			chk.Float64(tst, io.Sf("Y00(%g,%g)", θ, φ), 1e-15, SphericalHarmonic(0, 0, θ, φ), c0)
+			chk.Float64(tst, io.Sf("Y1-1(%g,%g)", θ, φ), 1e-15, SphericalHarmonic(1, -1, θ, φ), c1*y)
+			chk.Float64(tst, io.Sf("Y10(%g,%g)", θ, φ), 1e-15, SphericalHarmonic(1, 0, θ, φ), c1*z)
+			chk.Float64(tst, io.Sf("Y11(%g,%g)", θ, φ), 1e-15, SphericalHarmonic(1, 1, θ, φ), c1*x)
+			chk.Float64(tst, io.Sf("Y2-2(%g,%g)", θ, φ), 1e-15, SphericalHarmonic(2, -2, θ, φ), c2*x*y)
+			chk.Float64(tst, io.Sf("Y21(%g,%g)", θ, φ), 1e-15, SphericalHarmonic(2, 1, θ, φ), c2*x*z)
+			chk.Float64(tst, io.Sf("Y22(%g,%g)", θ, φ), 1e-15, SphericalHarmonic(2, 2, θ, φ), c2*(x*x-y*y)/2)
+		}
+	}
+
+	// product rule: Gauss-Legendre in cos(θ) and trapezoidal rule (exact for trigonometric
+	// polynomials of degree < nφ) in φ
+	nθ, nφ := 8, 16
+	X, W := gaussLegendreRule(nθ)
+	dφ := 2.0 * math.Pi / float64(nφ)
+
+	// orthonormality with l ≤ 4
+	io.Pl()
+	var lms [][2]int
+	for l := 0; l <= 4; l++ {
+		for m := -l; m <= l; m++ {
+			lms = append(lms, [2]int{l, m})
+		}
+	}
+	for _, a := range lms {
+		for _, b := range lms {
+			res := 0.0
+			for i := 0; i < nθ; i++ {
+				θ := math.Acos(X[i])
+				for j := 0; j < nφ; j++ {
+					φ := float64(j) * dφ
+					res += W[i] * dφ * SphericalHarmonic(a[0], a[1], θ, φ) * SphericalHarmonic(b[0], b[1], θ, φ)
+				}
+			}
+			cor := 0.0
+			if a == b {
+				cor = 1.0
+			}
+			chk.Float64(tst, io.Sf("<Y%d%d,Y%d%d>", a[0], a[1], b[0], b[1]), 1e-14, res, cor)
+		}
+	}
+
+	// invalid m
+	defer func() {
+		if err := recover(); err != nil {
+			io.Pf("OK: |m| > l caused panic\n")
+		} else {
+			tst.Errorf("|m| > l should cause panic\n")
+		}
+	}()
+	SphericalHarmonic(2, -3, 0.5, 0.5)
+}
@@ -0,0 +1,151 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestMel01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Mel01. mel scale and filterbank")
+
+	// mel scale
+	chk.Float64(tst, "mel(0)", 1e-17, HzToMel(0), 0)
+	chk.Float64(tst, "mel(700)", 1e-12, HzToMel(700), 2595*math.Log10(2))
+	for _, f := range []float64{10, 440, 1000, 8000} {
+		chk.Float64(tst, io.Sf("Hz(mel(%g))", f), 1e-10, MelToHz(HzToMel(f)), f)
+	}
+
+	// filterbank
+	nFilters, fftLen := 26, 1024
+	fs, fmin, fmax := 16000.0, 100.0, 7000.0
+	fb := MelFilterbank(nFilters, fftLen, fs, fmin, fmax)
+	chk.Int(tst, "number of filters", len(fb), nFilters)
+	chk.Int(tst, "number of bins", len(fb[0]), fftLen/2+1)
+
+	// centres of the first and last filters
+	dm := (HzToMel(fmax) - HzToMel(fmin)) / float64(nFilters+1)
+	cfirst := MelToHz(HzToMel(fmin) + dm)
+	clast := MelToHz(HzToMel(fmin) + float64(nFilters)*dm)
+
+	// the filters partition the frequency axis
+	for k := 0; k <= fftLen/2; k++ {
+		f := float64(k) * fs / float64(fftLen)
+		sum := 0.0
+		for i := 0; i < nFilters; i++ {
+			w := fb[i][k]
+			if w < 0 || w > 1 {
+				tst.Errorf("weight of filter %d at bin %d must be in [0, 1]. w = %g\n", i, k, w)
+				return
+			}
+			sum += w
+		}
+		switch {
+		case f <= fmin || f >= fmax:
+			chk.Float64(tst, io.Sf("f = %7.2f: Σ filters", f), 1e-17, sum, 0)
+		case f >= cfirst && f <= clast:
+			chk.Float64(tst, io.Sf("f = %7.2f: Σ filters", f), 1e-14, sum, 1)
+		}
+	}
+
+	// the peaks are increasing and the filters overlap only with their neighbours
+	kprev := -1
+	for i := 0; i < nFilters; i++ {
+		kmax := 0
+		for k := range fb[i] {
+			if fb[i][k] > fb[i][kmax] {
+				kmax = k
+			}
+			if fb[i][k] > 0 {
+				for j := 0; j < nFilters; j++ {
+					if (j < i-1 || j > i+1) && fb[j][k] > 0 {
+						tst.Errorf("filters %d and %d must not overlap at bin %d\n", i, j, k)
+						return
+					}
+				}
+			}
+		}
+		if kmax <= kprev {
+			tst.Errorf("the peaks of the filters must be increasing\n")
+			return
+		}
+		kprev = kmax
+	}
+
+	// errors
+	defer func() {
+		if err := recover(); err == nil {
+			tst.Errorf("fmax > fs/2 should have caused a panic\n")
+		} else {
+			io.Pf("OK: fmax > fs/2 caused a panic\n")
+		}
+	}()
+	MelFilterbank(nFilters, fftLen, fs, fmin, 9000)
+}
+
+func TestMel02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Mel02. mel spectrogram of a pure tone")
+
+	// pure tone
+	fs, f0 := 16000.0, 1000.0
+	n := 4096
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		x[i] = math.Sin(2 * math.Pi * f0 * float64(i) / fs)
+	}
+
+	// mel spectrogram
+	winLen, hop, nFilters := 512, 256, 40
+	mel, err := MelSpectrogram(x, winLen, hop, nFilters, fs)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Int(tst, "number of frames", len(mel), 1+(n-winLen+hop-1)/hop)
+	chk.Int(tst, "number of bands", len(mel[0]), nFilters)
+
+	// expected band: the one with centre closest to f0
+	dm := HzToMel(fs/2) / float64(nFilters+1)
+	jexp := 0
+	for j := 0; j < nFilters; j++ {
+		if math.Abs(MelToHz(float64(j+1)*dm)-f0) < math.Abs(MelToHz(float64(jexp+1)*dm)-f0) {
+			jexp = j
+		}
+	}
+	io.Pforan("expected band = %d\n", jexp)
+
+	// the tone lights up the expected band in all frames
+	for i := 0; i < len(mel); i++ {
+		jmax := 0
+		for j := 0; j < nFilters; j++ {
+			if mel[i][j] > mel[i][jmax] {
+				jmax = j
+			}
+		}
+		chk.Int(tst, io.Sf("frame %2d: dominant band", i), jmax, jexp)
+	}
+
+	// errors
+	if _, err = MelSpectrogram(x, 500, hop, nFilters, fs); err == nil {
+		tst.Errorf("winLen=500 should have caused an error\n")
+		return
+	}
+	if _, err = MelSpectrogram(x, winLen, hop, 0, fs); err == nil {
+		tst.Errorf("nFilters=0 should have caused an error\n")
+		return
+	}
+	if _, err = MelSpectrogram(x, winLen, hop, nFilters, 0); err == nil {
+		tst.Errorf("fs=0 should have caused an error\n")
+		return
+	}
+}
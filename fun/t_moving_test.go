@@ -0,0 +1,75 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestMoving01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Moving01. moving average and RMS")
+
+	// simple data
+	x := []float64{1, 2, 3, 4, 5}
+	a, err := MovingAverage(x, 3)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "average (window=3)", 1e-15, a, []float64{2, 3, 4})
+	r, err := MovingRMS([]float64{3, -4, 3, -4}, 2)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "RMS (window=2)    ", 1e-15, r, []float64{math.Sqrt(12.5), math.Sqrt(12.5), math.Sqrt(12.5)})
+
+	// running sums versus naive windowed computation
+	rng := rand.New(rand.NewSource(1357))
+	x = randomArray(rng, 2000)
+	for _, window := range []int{1, 2, 7, 64, 2000} {
+		a, err = MovingAverage(x, window)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		r, err = MovingRMS(x, window)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		m := len(x) - window + 1
+		aNaive := make([]float64, m)
+		rNaive := make([]float64, m)
+		for i := 0; i < m; i++ {
+			for j := 0; j < window; j++ {
+				aNaive[i] += x[i+j]
+				rNaive[i] += x[i+j] * x[i+j]
+			}
+			aNaive[i] /= float64(window)
+			rNaive[i] = math.Sqrt(rNaive[i] / float64(window))
+		}
+		chk.Int(tst, io.Sf("window=%4d: len", window), len(a), m)
+		chk.Array(tst, io.Sf("window=%4d: average", window), 1e-13, a, aNaive)
+		chk.Array(tst, io.Sf("window=%4d: RMS    ", window), 1e-13, r, rNaive)
+	}
+
+	// invalid window
+	if _, err = MovingAverage(x, 0); err == nil {
+		tst.Errorf("window = 0 should have caused an error\n")
+		return
+	}
+	if _, err = MovingRMS([]float64{1, 2}, 3); err == nil {
+		tst.Errorf("window > len(x) should have caused an error\n")
+		return
+	}
+}
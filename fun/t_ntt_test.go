@@ -0,0 +1,89 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// intConvolveSlow computes the modular convolution by brute force
+func intConvolveSlow(a, b []uint64, mod uint64) (c []uint64) {
+	c = make([]uint64, len(a)+len(b)-1)
+	for i := 0; i < len(a); i++ {
+		for j := 0; j < len(b); j++ {
+			c[i+j] = (c[i+j] + mulMod(a[i]%mod, b[j]%mod, mod)) % mod
+		}
+	}
+	return
+}
+
+func TestIntConvolve01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("IntConvolve01. exact integer convolution with NTT")
+
+	// small exact product: (1+x)³ ⋅ (1+x)² = (1+x)⁵
+	c, err := IntConvolve([]uint64{1, 3, 3, 1}, []uint64{1, 2, 1}, NttPrime)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Ints(tst, "(1+x)⁵", toInts(c), []int{1, 5, 10, 10, 5, 1})
+
+	// random data versus brute force, with large values and two moduli
+	rng := rand.New(rand.NewSource(99))
+	for _, mod := range []uint64{NttPrime, 4179340454199820289} { // the second is 29⋅2⁵⁷ + 1
+		g := nttPrimitiveRoot(mod)
+		io.Pforan("mod = %d  primitive root = %d\n", mod, g)
+		for _, mn := range [][]int{{1, 1}, {2, 3}, {17, 33}, {100, 257}} {
+			a := make([]uint64, mn[0])
+			b := make([]uint64, mn[1])
+			for i := range a {
+				a[i] = rng.Uint64()
+			}
+			for i := range b {
+				b[i] = rng.Uint64()
+			}
+			c, err := IntConvolve(a, b, mod)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			ref := intConvolveSlow(a, b, mod)
+			chk.Int(tst, "len(c)", len(c), len(ref))
+			for k := range ref {
+				if c[k] != ref[k] {
+					tst.Errorf("mod=%d %dx%d: c[%d] = %d != %d\n", mod, mn[0], mn[1], k, c[k], ref[k])
+					return
+				}
+			}
+		}
+	}
+	chk.Int(tst, "primitive root of NttPrime", int(nttPrimitiveRoot(NttPrime)), 3)
+
+	// errors
+	if _, err = IntConvolve(nil, []uint64{1}, NttPrime); err == nil {
+		tst.Errorf("empty input should have caused an error\n")
+	}
+	if _, err = IntConvolve([]uint64{1, 2}, []uint64{1, 2}, 1000); err == nil {
+		tst.Errorf("non-prime modulus should have caused an error\n")
+	}
+	if _, err = IntConvolve(make([]uint64, 10), make([]uint64, 10), 13); err == nil {
+		tst.Errorf("mod-1 not divisible by transform size should have caused an error\n")
+	}
+}
+
+// toInts converts uint64 values to int
+func toInts(a []uint64) (b []int) {
+	b = make([]int, len(a))
+	for i, v := range a {
+		b[i] = int(v)
+	}
+	return
+}
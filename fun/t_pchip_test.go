@@ -0,0 +1,111 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/plt"
+	"github.com/cpmech/gosl/utl"
+)
+
+func TestPchip01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Pchip01. monotone interpolation of step-like data")
+
+	xs := []float64{0, 1, 2, 3, 3.5, 4, 5, 6, 7.5, 9}
+	ys := []float64{0, 0, 0.05, 0.1, 0.9, 1, 1, 1, 1.2, 1.2}
+	o, err := NewPchip(xs, ys)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	s, err := NewCubicSpline(xs, ys, "natural")
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+
+	// interpolation
+	for i, x := range xs {
+		chk.Float64(tst, io.Sf("P(x%d)", i), 1e-15, o.Eval(x), ys[i])
+	}
+
+	// monotonicity and no overshoot within each segment
+	X := utl.LinSpace(xs[0], xs[len(xs)-1], 901)
+	P := utl.GetMapped(X, func(x float64) float64 { return o.Eval(x) })
+	S := utl.GetMapped(X, func(x float64) float64 { return s.Eval(x) })
+	for k := 1; k < len(X); k++ {
+		if P[k] < P[k-1]-1e-15 {
+			tst.Errorf("Pchip is not monotone at x = %g: %g < %g\n", X[k], P[k], P[k-1])
+			return
+		}
+	}
+	splineOvershoots := false
+	for k, x := range X {
+		i := utl.Imax(sort.SearchFloat64s(xs, x)-1, 0)
+		lo, hi := ys[i], ys[i+1]
+		if P[k] < lo-1e-15 || P[k] > hi+1e-15 {
+			tst.Errorf("Pchip overshoots at x = %g: %g is not in [%g, %g]\n", x, P[k], lo, hi)
+			return
+		}
+		if S[k] < lo-1e-3 || S[k] > hi+1e-3 {
+			splineOvershoots = true
+		}
+	}
+	if !splineOvershoots {
+		tst.Errorf("the natural cubic spline should have overshot with this data\n")
+		return
+	}
+
+	if chk.Verbose {
+		plt.Reset(true, &plt.A{WidthPt: 400, Dpi: 150})
+		plt.Plot(xs, ys, &plt.A{C: "k", Ls: "none", M: "o", L: "data", NoClip: true})
+		plt.Plot(X, P, &plt.A{C: "r", Ls: "-", L: "pchip", NoClip: true})
+		plt.Plot(X, S, &plt.A{C: "b", Ls: "--", L: "natural spline", NoClip: true})
+		plt.Gll("x", "y", nil)
+		plt.HideTRborders()
+		plt.Save("/tmp/gosl/fun", "pchip01")
+	}
+}
+
+func TestPchip02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Pchip02. linear data and invalid input")
+
+	// straight line is reproduced exactly
+	xs := []float64{-2, -1.5, 0, 0.1, 1, 3}
+	o, err := NewPchip(xs, utl.GetMapped(xs, func(x float64) float64 { return 1 - 0.5*x }))
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for _, x := range []float64{-2.5, -2, -1.7, 0.05, 2, 3.5} {
+		chk.Float64(tst, io.Sf("P(%5.2f)", x), 1e-15, o.Eval(x), 1-0.5*x)
+	}
+
+	// two points
+	o, err = NewPchip([]float64{1, 3}, []float64{2, 6})
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Float64(tst, "P(2)", 1e-15, o.Eval(2), 4)
+
+	// errors
+	if _, err = NewPchip([]float64{0, 1}, []float64{0}); err == nil {
+		tst.Errorf("different lengths should have caused an error\n")
+		return
+	}
+	if _, err = NewPchip([]float64{0, 2, 1}, []float64{0, 1, 2}); err == nil {
+		tst.Errorf("non-increasing knots should have caused an error\n")
+		return
+	}
+}
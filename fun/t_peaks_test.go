@@ -0,0 +1,108 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestFindPeaks01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FindPeaks01. simple data, plateaus and minimum distance")
+
+	y := []float64{0, 1, 0, 2, 2, 2, 0, 3, 1, 4, 0, 5, 5}
+	chk.Ints(tst, "all peaks         ", FindPeaks(y, math.Inf(-1), 0), []int{1, 4, 7, 9})
+	chk.Ints(tst, "minHeight = 2.5   ", FindPeaks(y, 2.5, 0), []int{7, 9})
+	chk.Ints(tst, "minDistance = 3   ", FindPeaks(y, math.Inf(-1), 3), []int{1, 4, 9})
+	chk.Ints(tst, "minDistance = 5   ", FindPeaks(y, math.Inf(-1), 5), []int{4, 9})
+	chk.Ints(tst, "minDistance = 100 ", FindPeaks(y, math.Inf(-1), 100), []int{9})
+	chk.Ints(tst, "no peaks          ", FindPeaks([]float64{1, 2, 3}, 0, 0), nil)
+	chk.Ints(tst, "empty             ", FindPeaks(nil, 0, 0), nil)
+}
+
+func TestFindPeaks02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("FindPeaks02. spectral lines of noisy signal")
+
+	// three tones at bins 50, 120 and 300 plus uniform noise
+	N := 1024
+	rng := rand.New(rand.NewSource(1234))
+	noise := randomArray(rng, N)
+	x := make([]float64, N)
+	for j := 0; j < N; j++ {
+		θ := 2 * math.Pi * float64(j) / float64(N)
+		x[j] = math.Sin(50*θ) + 0.5*math.Cos(120*θ) + 0.25*math.Sin(300*θ) + 0.5*noise[j]
+	}
+	X, err := RealFourierTrans(x)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	mag := Magnitude(X)
+
+	// the noise creates many small peaks
+	all := FindPeaks(mag, 0, 0)
+	io.Pforan("number of local maxima = %d\n", len(all))
+	if len(all) < 50 {
+		tst.Errorf("the noise should have created many local maxima\n")
+		return
+	}
+
+	// spectral lines with |X| = N⋅A/2 = 512, 256 and 128
+	peaks := FindPeaks(mag, 64, 0)
+	io.Pforan("peaks = %v  |X| = %.1f, %.1f, %.1f\n", peaks, mag[50], mag[120], mag[300])
+	chk.Ints(tst, "peaks", peaks, []int{50, 120, 300})
+	chk.Ints(tst, "peaks with minDistance = 100", FindPeaks(mag, 64, 100), []int{50, 300})
+}
+
+func TestInterpolatePeak01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("InterpolatePeak01. parabolic interpolation of peaks")
+
+	// parabola: exact
+	y := make([]float64, 11)
+	for i := range y {
+		y[i] = 3 - 0.5*math.Pow(float64(i)-4.3, 2)
+	}
+	pos, height := InterpolatePeak(y, 4)
+	chk.Float64(tst, "parabola: pos   ", 1e-14, pos, 4.3)
+	chk.Float64(tst, "parabola: height", 1e-14, height, 3)
+
+	// sampled Gaussian: much better than the nearest bin
+	centre, σ := 20.3, 2.5
+	y = make([]float64, 41)
+	for i := range y {
+		y[i] = math.Exp(-math.Pow(float64(i)-centre, 2) / (2 * σ * σ))
+	}
+	idx := FindPeaks(y, 0.5, 0)
+	chk.Ints(tst, "nearest bin", idx, []int{20})
+	pos, height = InterpolatePeak(y, idx[0])
+	io.Pforan("pos = %g  height = %g\n", pos, height)
+	errBin := math.Abs(float64(idx[0]) - centre)
+	errPos := math.Abs(pos - centre)
+	if errPos > 0.05*errBin {
+		tst.Errorf("interpolated position error (%g) should be much smaller than the bin error (%g)\n", errPos, errBin)
+		return
+	}
+	chk.Float64(tst, "Gaussian: height", 2e-3, height, 1)
+
+	// boundaries and flat data
+	pos, height = InterpolatePeak([]float64{5, 3, 1}, 0)
+	chk.Float64(tst, "first index: pos", 1e-17, pos, 0)
+	chk.Float64(tst, "first index: height", 1e-17, height, 5)
+	pos, height = InterpolatePeak([]float64{1, 3, 5}, 2)
+	chk.Float64(tst, "last index: pos", 1e-17, pos, 2)
+	chk.Float64(tst, "last index: height", 1e-17, height, 5)
+	pos, _ = InterpolatePeak([]float64{1, 1, 1}, 1)
+	chk.Float64(tst, "flat: pos", 1e-17, pos, 1)
+}
@@ -0,0 +1,146 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestRBF01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("RBF01. scattered data in 2D")
+
+	// smooth function sampled at scattered points in [0,1]²
+	f := func(x, y float64) float64 { return math.Sin(2*x)*math.Cos(1.5*y) + 0.5*x*y }
+	rng := rand.New(rand.NewSource(1234))
+	N := 150
+	points := make([][]float64, N)
+	values := make([]float64, N)
+	for i := 0; i < N; i++ {
+		points[i] = []float64{rng.Float64(), rng.Float64()}
+		values[i] = f(points[i][0], points[i][1])
+	}
+
+	// off-node points in the interior
+	M := 200
+	tests := make([][]float64, M)
+	for i := 0; i < M; i++ {
+		tests[i] = []float64{0.1 + 0.8*rng.Float64(), 0.1 + 0.8*rng.Float64()}
+	}
+
+	for _, c := range []struct {
+		kind   string
+		eps    float64
+		tolOff float64
+	}{
+		{"gaussian", 3, 1e-4},
+		{"multiquadric", 2, 1e-3},
+		{"thin_plate", 0, 5e-3},
+	} {
+		o, err := NewRBF(points, values, c.kind, c.eps)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+
+		// nodes are reproduced
+		errNodes := 0.0
+		for i := 0; i < N; i++ {
+			errNodes = math.Max(errNodes, math.Abs(o.Eval(points[i])-values[i]))
+		}
+
+		// off-node error
+		errOff := 0.0
+		for _, p := range tests {
+			errOff = math.Max(errOff, math.Abs(o.Eval(p)-f(p[0], p[1])))
+		}
+		io.Pforan("%-13s: max error at nodes = %.3e  off nodes = %.3e\n", c.kind, errNodes, errOff)
+		chk.Float64(tst, c.kind+": error at nodes", 1e-8, errNodes, 0)
+		if errOff > c.tolOff {
+			tst.Errorf("%s: the error off nodes is too large: %g\n", c.kind, errOff)
+			return
+		}
+	}
+
+	// thin-plate reproduces linear functions exactly
+	lin := make([]float64, N)
+	for i := 0; i < N; i++ {
+		lin[i] = 1 + 2*points[i][0] - 3*points[i][1]
+	}
+	o, err := NewRBF(points, lin, "thin_plate", 0)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for _, p := range tests[:10] {
+		chk.Float64(tst, io.Sf("thin_plate: linear at (%.3f,%.3f)", p[0], p[1]), 1e-10, o.Eval(p), 1+2*p[0]-3*p[1])
+	}
+}
+
+func TestRBF02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("RBF02. invalid input")
+
+	points := [][]float64{{0, 0}, {1, 0}, {0, 1}, {1, 1}}
+	values := []float64{0, 1, 1, 2}
+	if _, err := NewRBF(points, values[:3], "gaussian", 1); err == nil {
+		tst.Errorf("different lengths should have caused an error\n")
+		return
+	}
+	if _, err := NewRBF([][]float64{{0, 0}, {1}, {0, 1}, {1, 1}}, values, "gaussian", 1); err == nil {
+		tst.Errorf("different dimensions should have caused an error\n")
+		return
+	}
+	if _, err := NewRBF([][]float64{{0, 0}, {1, 0}, {0, 0}, {1, 1}}, values, "gaussian", 1); err == nil {
+		tst.Errorf("repeated points should have caused an error\n")
+		return
+	}
+	if _, err := NewRBF(points, values, "cubic", 1); err == nil {
+		tst.Errorf("unknown kind should have caused an error\n")
+		return
+	}
+	if _, err := NewRBF(points, values, "multiquadric", 0); err == nil {
+		tst.Errorf("epsilon = 0 should have caused an error\n")
+		return
+	}
+	if _, err := NewRBF(points[:2], values[:2], "thin_plate", 0); err == nil {
+		tst.Errorf("too few points for the thin-plate kernel should have caused an error\n")
+		return
+	}
+	collinear := [][]float64{{0, 0}, {1, 1}, {2, 2}, {3, 3}, {4, 4}}
+	if _, err := NewRBF(collinear, []float64{0, 1, 2, 3, 4}, "thin_plate", 0); err == nil {
+		tst.Errorf("collinear points with the thin-plate kernel should have caused an error\n")
+		return
+	}
+	coplanar := [][]float64{{0, 0, 1}, {1, 0, 1}, {0, 1, 1}, {1, 1, 1}, {0.5, 0.3, 1}}
+	if _, err := NewRBF(coplanar, []float64{0, 1, 2, 3, 4}, "thin_plate", 0); err == nil {
+		tst.Errorf("coplanar points with the thin-plate kernel should have caused an error\n")
+		return
+	}
+	if _, err := NewRBF(collinear, []float64{0, 1, 2, 3, 4}, "gaussian", 1); err != nil {
+		tst.Errorf("collinear points with the Gaussian kernel should be accepted: %v\n", err)
+		return
+	}
+	o, err := NewRBF(points, values, "gaussian", 1)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	defer func() {
+		if err := recover(); err == nil {
+			tst.Errorf("wrong dimension should have caused a panic\n")
+		} else {
+			io.Pf("OK: wrong dimension caused a panic\n")
+		}
+	}()
+	o.Eval([]float64{0, 0, 0})
+}
@@ -0,0 +1,140 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+func TestResampleFFT01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ResampleFFT01. up- and downsampling of band-limited signal")
+
+	// band-limited periodic signal
+	signal := func(t float64) float64 {
+		return 1.5 + math.Sin(2*math.Pi*t) + 0.3*math.Cos(2*math.Pi*5*t+0.4)
+	}
+	sample := func(n int) (x []float64) {
+		x = make([]float64, n)
+		for j := 0; j < n; j++ {
+			x[j] = signal(float64(j) / float64(n))
+		}
+		return
+	}
+	N := 32
+	x := sample(N)
+
+	// upsampling gives the exact samples at the finer grid
+	for _, M := range []int{64, 256} {
+		y, err := ResampleFFT(x, M)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Array(tst, io.Sf("upsampled to %d", M), 1e-13, y, sample(M))
+
+		// back to the original length
+		z, err := ResampleFFT(y, N)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Array(tst, io.Sf("%d → %d → %d", N, M, N), 1e-13, z, x)
+	}
+
+	// downsampling keeps the frequencies below the new Nyquist frequency
+	y, err := ResampleFFT(x, 16)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "downsampled to 16", 1e-13, y, sample(16))
+
+	// same length
+	y, err = ResampleFFT(x, N)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "same length", 1e-14, y, x)
+
+	// errors
+	if _, err = ResampleFFT(x, 48); err == nil {
+		tst.Errorf("newLen=48 should have caused an error\n")
+	}
+	if _, err = ResampleFFT(x[:30], 64); err == nil {
+		tst.Errorf("N=30 should have caused an error\n")
+	}
+}
+
+func TestSincInterp01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("SincInterp01. band-limited reconstruction")
+
+	// Gaussian-modulated sinusoid: the spectrum is concentrated around 1.5 Hz, far below the
+	// Nyquist frequency 5 Hz, and the signal decays to ~1e-15 at the ends of the samples
+	f := func(t float64) float64 {
+		return math.Sin(3*math.Pi*t) * math.Exp(-t*t/0.72)
+	}
+	n := 101
+	x := utl.LinSpace(-5, 5, n)
+	y := make([]float64, n)
+	for j := 0; j < n; j++ {
+		y[j] = f(x[j])
+	}
+
+	// off-grid points
+	xNew := utl.LinSpace(-4.013, 3.987, 57)
+	yNew, err := SincInterp(x, y, xNew)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	lin := NewDataInterp("lin", 1, x, y)
+	errSinc, errLin := 0.0, 0.0
+	for i, t := range xNew {
+		errSinc = math.Max(errSinc, math.Abs(yNew[i]-f(t)))
+		errLin = math.Max(errLin, math.Abs(lin.P(t)-f(t)))
+	}
+	io.Pforan("max error: sinc = %.3e  linear = %.3e\n", errSinc, errLin)
+	if errSinc > 1e-13 {
+		tst.Errorf("the error of the sinc interpolation is too large: %g\n", errSinc)
+		return
+	}
+	if errLin < 1e-3 {
+		tst.Errorf("the error of the linear interpolation should be large: %g\n", errLin)
+		return
+	}
+
+	// nodes are reproduced
+	yNodes, err := SincInterp(x, y, x)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "yNew at nodes", 1e-14, yNodes, y)
+
+	// errors
+	if _, err = SincInterp(x, y[:n-1], xNew); err == nil {
+		tst.Errorf("different lengths should have caused an error\n")
+		return
+	}
+	if _, err = SincInterp(x[:1], y[:1], xNew); err == nil {
+		tst.Errorf("a single sample should have caused an error\n")
+		return
+	}
+	x[50] += 1e-3
+	if _, err = SincInterp(x, y, xNew); err == nil {
+		tst.Errorf("non-uniform spacing should have caused an error\n")
+		return
+	}
+}
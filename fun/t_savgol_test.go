@@ -0,0 +1,77 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestSavitzkyGolay01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("SavitzkyGolay01. smoothing and exactness for quadratics")
+
+	// known coefficients: window 5, order 2 ⇒ [-3, 12, 17, 12, -3] / 35
+	c := savitzkyGolayCoefs(5, 2, 2)
+	chk.Array(tst, "coefficients (5,2)", 1e-15, c, []float64{-3.0 / 35, 12.0 / 35, 17.0 / 35, 12.0 / 35, -3.0 / 35})
+
+	// quadratic trend is preserved exactly, including the boundaries
+	n := 50
+	quad := make([]float64, n)
+	for i := 0; i < n; i++ {
+		t := float64(i)
+		quad[i] = 2 - 0.3*t + 0.05*t*t
+	}
+	for _, wp := range [][]int{{5, 2}, {11, 2}, {11, 3}, {21, 4}} {
+		s, err := SavitzkyGolay(quad, wp[0], wp[1])
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Array(tst, io.Sf("quadratic: window=%2d order=%d", wp[0], wp[1]), 1e-11, s, quad)
+	}
+
+	// noisy sinusoid
+	n = 400
+	rng := rand.New(rand.NewSource(33))
+	clean := make([]float64, n)
+	noisy := make([]float64, n)
+	for i := 0; i < n; i++ {
+		clean[i] = math.Sin(2 * math.Pi * float64(i) / 200)
+		noisy[i] = clean[i] + 0.1*rng.NormFloat64()
+	}
+	s, err := SavitzkyGolay(noisy, 21, 3)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	rms := func(a []float64) (r float64) {
+		for i := 0; i < n; i++ {
+			r += (a[i] - clean[i]) * (a[i] - clean[i]) / float64(n)
+		}
+		return math.Sqrt(r)
+	}
+	io.Pforan("RMS error: noisy = %g, smoothed = %g\n", rms(noisy), rms(s))
+	if rms(s) > 0.5*rms(noisy) {
+		tst.Errorf("the smoothed data should be much closer to the clean signal\n")
+		return
+	}
+
+	// errors
+	if _, err = SavitzkyGolay(noisy, 4, 2); err == nil {
+		tst.Errorf("even window should have caused an error\n")
+	}
+	if _, err = SavitzkyGolay(noisy, 5, 5); err == nil {
+		tst.Errorf("polyOrder ≥ window should have caused an error\n")
+	}
+	if _, err = SavitzkyGolay(noisy[:3], 5, 2); err == nil {
+		tst.Errorf("len(y) < window should have caused an error\n")
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestSignalGen01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("SignalGen01. sine and square waves")
+
+	// sine: values
+	x := Sine(2, 3, math.Pi/2, 16, 9)
+	chk.Array(tst, "sine (cosine)", 1e-15, x, []float64{3, 3 / math.Sqrt2, 0, -3 / math.Sqrt2, -3, -3 / math.Sqrt2, 0, 3 / math.Sqrt2, 3})
+
+	// sine: dominant bin
+	N, fs := 1024, 2048.0
+	for _, f := range []float64{50, 300, 700} {
+		X, err := RealFourierTrans(Sine(f, 1, 0.3, fs, N))
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		freqs := RfftFreq(N, 1/fs)
+		chk.Float64(tst, io.Sf("f=%3g: dominant frequency", f), 1e-12, freqs[spectralArgmax(X)], f)
+	}
+
+	// square
+	x = Square(4, 32, 20)
+	chk.Array(tst, "square", 1e-17, x, []float64{1, 1, 1, 1, -1, -1, -1, -1, 1, 1, 1, 1, -1, -1, -1, -1, 1, 1, 1, 1})
+	chk.Array(tst, "empty", 1e-17, Square(4, 32, 0), nil)
+}
+
+func TestSignalGen02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("SignalGen02. linear chirp")
+
+	// chirp from 50 to 400 Hz
+	N, fs, f0, f1 := 4096, 2000.0, 50.0, 400.0
+	x := Chirp(f0, f1, fs, N)
+	chk.Float64(tst, "x[0]", 1e-17, x[0], 0)
+
+	// instantaneous frequency from the phase of the analytic signal
+	z, err := Hilbert(x)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	phase := make([]float64, N)
+	for j := 0; j < N; j++ {
+		phase[j] = math.Atan2(imag(z[j]), real(z[j]))
+	}
+	phase = Unwrap(phase)
+	T := float64(N-1) / fs
+	for _, j := range []int{N / 10, N / 4, N / 2, 3 * N / 4, 9 * N / 10} {
+		finst := (phase[j+1] - phase[j-1]) * fs / (4 * math.Pi)
+		fexact := f0 + (f1-f0)*(float64(j)/fs)/T
+		chk.Float64(tst, io.Sf("j=%4d: instantaneous frequency", j), 0.01*fexact, finst, fexact)
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// spectralArgmax returns the index of the bin with the largest magnitude
+func spectralArgmax(X []complex128) (imax int) {
+	for k := 0; k < len(X); k++ {
+		if cmplx.Abs(X[k]) > cmplx.Abs(X[imax]) {
+			imax = k
+		}
+	}
+	return
+}
+
+func TestSpectrogram01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Spectrogram01. STFT of a chirp")
+
+	// linear chirp from f0 to f1 within T seconds
+	fs := 1024.0
+	T := 2.0
+	f0, f1 := 50.0, 400.0
+	n := int(fs * T)
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		t := float64(i) / fs
+		x[i] = math.Sin(2 * math.Pi * (f0*t + 0.5*(f1-f0)/T*t*t))
+	}
+
+	// spectrogram
+	winLen, hop := 128, 64
+	win, _ := Window("hann", winLen)
+	frames, err := Spectrogram(x, winLen, hop, win)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Int(tst, "number of frames", len(frames), 1+(n-winLen+hop-1)/hop)
+	chk.Int(tst, "number of bins", len(frames[0]), winLen/2+1)
+
+	// the dominant bin follows the instantaneous frequency
+	df := fs / float64(winLen)
+	for i := 0; i < len(frames)-1; i++ {
+		tc := (float64(i*hop) + float64(winLen)/2) / fs // time at the centre of the frame
+		fi := f0 + (f1-f0)*tc/T
+		kmax := spectralArgmax(frames[i])
+		io.Pf("frame %2d: t = %.3f  f = %6.1f  dominant bin = %2d (%6.1f)\n", i, tc, fi, kmax, float64(kmax)*df)
+		if math.Abs(float64(kmax)*df-fi) > 1.5*df {
+			tst.Errorf("dominant frequency of frame %d is incorrect\n", i)
+			return
+		}
+	}
+	first, last := spectralArgmax(frames[0]), spectralArgmax(frames[len(frames)-2])
+	if last <= first {
+		tst.Errorf("dominant bin should move from low to high frequencies: %d → %d\n", first, last)
+	}
+
+	// errors
+	if _, err = Spectrogram(x, 100, hop, nil); err == nil {
+		tst.Errorf("winLen=100 should have caused an error\n")
+	}
+	if _, err = Spectrogram(x, winLen, 0, nil); err == nil {
+		tst.Errorf("hop=0 should have caused an error\n")
+	}
+	if _, err = Spectrogram(x, winLen, hop, win[1:]); err == nil {
+		tst.Errorf("wrong window length should have caused an error\n")
+	}
+}
+
+func TestSpectrogram02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Spectrogram02. partial last frame")
+
+	// 10 samples, winLen = 4 and hop = 3 ⇒ frames start at 0, 3 and 6
+	x := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	frames, err := Spectrogram(x, 4, 3, nil)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Int(tst, "number of frames", len(frames), 3)
+	for i, seg := range [][]float64{{1, 2, 3, 4}, {4, 5, 6, 7}, {7, 8, 9, 10}} {
+		X, _ := RealFourierTrans(seg)
+		chk.ArrayC(tst, io.Sf("frame %d", i), 1e-14, frames[i], X)
+	}
+
+	// winLen = 8 and hop = 3 ⇒ frames start at 0 and 3 (the last one is partial)
+	frames, _ = Spectrogram(x, 8, 3, nil)
+	X, _ := RealFourierTrans([]float64{4, 5, 6, 7, 8, 9, 10, 0})
+	chk.Int(tst, "number of frames", len(frames), 2)
+	chk.ArrayC(tst, "zero-padded frame", 1e-14, frames[1], X)
+}
+
+func TestPeriodogram01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Periodogram01. Parseval's theorem")
+
+	// signal with DC, Nyquist and other components
+	N, fs := 256, 1000.0
+	x := make([]float64, N)
+	for j := 0; j < N; j++ {
+		t := float64(j) / fs
+		x[j] = 0.3 + 2*math.Sin(2*math.Pi*125*t) + 0.5*math.Cos(math.Pi*fs*t) + real(fftTestData(N)[j])
+	}
+	meanSquare := 0.0
+	for j := 0; j < N; j++ {
+		meanSquare += x[j] * x[j] / float64(N)
+	}
+
+	// rectangular window
+	freqs, psd, err := Periodogram(x, fs, nil)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Int(tst, "len(freqs)", len(freqs), N/2+1)
+	chk.Int(tst, "len(psd)", len(psd), N/2+1)
+	chk.Float64(tst, "Nyquist frequency", 1e-12, freqs[N/2], fs/2)
+	df := fs / float64(N)
+	power := 0.0
+	for k := 0; k < len(psd); k++ {
+		power += psd[k] * df
+	}
+	chk.Float64(tst, "Σ psd Δf = mean(x²)", 1e-12, power, meanSquare)
+
+	// with window: Σ psd Δf = Σ (x w)² / Σ w²
+	win, _ := Window("hann", N)
+	_, psd, err = Periodogram(x, fs, win)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	num, den := 0.0, 0.0
+	for j := 0; j < N; j++ {
+		num += x[j] * x[j] * win[j] * win[j]
+		den += win[j] * win[j]
+	}
+	power = 0.0
+	for k := 0; k < len(psd); k++ {
+		power += psd[k] * df
+	}
+	chk.Float64(tst, "Σ psd Δf = Σ(x w)²/Σw²", 1e-12, power, num/den)
+
+	// peak
+	chk.Float64(tst, "frequency of peak", 1e-12, freqs[spectralArgmax(toComplex(psd))], 125)
+
+	// errors
+	if _, _, err = Periodogram(x[:100], fs, nil); err == nil {
+		tst.Errorf("N=100 should have caused an error\n")
+	}
+	if _, _, err = Periodogram(x, 0, nil); err == nil {
+		tst.Errorf("fs=0 should have caused an error\n")
+	}
+	if _, _, err = Periodogram(x, fs, win[:10]); err == nil {
+		tst.Errorf("wrong window length should have caused an error\n")
+	}
+}
+
+// toComplex converts a real array to complex
+func toComplex(x []float64) (z []complex128) {
+	z = make([]complex128, len(x))
+	for i, v := range x {
+		z[i] = complex(v, 0)
+	}
+	return
+}
+
+func TestWelch01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Welch01. averaged PSD of noisy sinusoid")
+
+	// noisy sinusoid
+	N, fs, f0 := 8192, 1024.0, 128.0
+	rng := rand.New(rand.NewSource(42))
+	x := make([]float64, N)
+	for j := 0; j < N; j++ {
+		x[j] = math.Sin(2*math.Pi*f0*float64(j)/fs) + rng.NormFloat64()
+	}
+
+	// single periodogram of one segment and Welch's estimate
+	segLen := 256
+	win, _ := Window("hann", segLen)
+	freqs1, psd1, err := Periodogram(x[:segLen], fs, win)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	freqs, psd, err := Welch(x, fs, segLen, segLen/2, win)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "freqs", 1e-15, freqs, freqs1)
+
+	// peak
+	chk.Float64(tst, "peak of periodogram", 1e-12, freqs1[spectralArgmax(toComplex(psd1))], f0)
+	chk.Float64(tst, "peak of Welch's PSD", 1e-12, freqs[spectralArgmax(toComplex(psd))], f0)
+
+	// relative variance of the noise floor (away from the peak and the ends)
+	relvar := func(p []float64) float64 {
+		var vals []float64
+		for k := 4; k < len(p)-4; k++ {
+			if math.Abs(freqs[k]-f0) > 10*fs/float64(segLen) {
+				vals = append(vals, p[k])
+			}
+		}
+		mean, sq := 0.0, 0.0
+		for _, v := range vals {
+			mean += v / float64(len(vals))
+		}
+		for _, v := range vals {
+			sq += (v - mean) * (v - mean) / float64(len(vals))
+		}
+		return sq / (mean * mean)
+	}
+	v1, v2 := relvar(psd1), relvar(psd)
+	io.Pforan("relative variance: periodogram = %g, Welch = %g\n", v1, v2)
+	if v2 > 0.1*v1 {
+		tst.Errorf("the variance of Welch's estimate (%g) should be much smaller than the periodogram's (%g)\n", v2, v1)
+		return
+	}
+
+	// errors
+	if _, _, err = Welch(x, fs, 100, 0, nil); err == nil {
+		tst.Errorf("segLen=100 should have caused an error\n")
+	}
+	if _, _, err = Welch(x, fs, segLen, segLen, nil); err == nil {
+		tst.Errorf("overlap=segLen should have caused an error\n")
+	}
+	if _, _, err = Welch(x[:100], fs, segLen, 0, nil); err == nil {
+		tst.Errorf("len(x) < segLen should have caused an error\n")
+	}
+}
+
+func TestCoherence01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Coherence01. low-pass filtered signal plus noise")
+
+	// y = lowpass(x) + small independent noise
+	N, fs := 16384, 1000.0
+	rng := rand.New(rand.NewSource(2024))
+	x := make([]float64, N)
+	noise := make([]float64, N)
+	for j := 0; j < N; j++ {
+		x[j] = rng.NormFloat64()
+		noise[j] = 0.05 * rng.NormFloat64()
+	}
+	win, _ := Window("hamming", 65)
+	h, err := FirLowpass(65, 0.3, win) // cutoff = 0.3 ⋅ fs/2 = 150 Hz
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	y, err := Convolve(x, h, "same")
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for j := 0; j < N; j++ {
+		y[j] += noise[j]
+	}
+
+	// coherence
+	freqs, coh, err := Coherence(x, y, fs, 256, 128)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	minPass, maxStop := 1.0, 0.0
+	for k, f := range freqs {
+		if coh[k] < 0 || coh[k] > 1 {
+			tst.Errorf("coherence must be in [0, 1]. coh[%d] = %g is invalid\n", k, coh[k])
+			return
+		}
+		if f < 120 {
+			minPass = math.Min(minPass, coh[k])
+		}
+		if f > 200 {
+			maxStop = math.Max(maxStop, coh[k])
+		}
+	}
+	io.Pforan("min coherence in pass band = %g\n", minPass)
+	io.Pforan("max coherence in stop band = %g\n", maxStop)
+	if minPass < 0.95 {
+		tst.Errorf("the coherence in the pass band (%g) should be close to 1\n", minPass)
+		return
+	}
+	if maxStop > 0.15 {
+		tst.Errorf("the coherence in the stop band (%g) should be close to 0\n", maxStop)
+		return
+	}
+
+	// errors
+	if _, _, err = Coherence(x, y[:100], fs, 256, 128); err == nil {
+		tst.Errorf("different lengths should have caused an error\n")
+	}
+	if _, _, err = Coherence(x, y, fs, 100, 0); err == nil {
+		tst.Errorf("segLen=100 should have caused an error\n")
+	}
+}
+
+func TestTransferFunction01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("TransferFunction01. frequency response of FIR filter")
+
+	// output = FIR(input) + noise
+	N, fs := 16384, 200.0
+	rng := rand.New(rand.NewSource(777))
+	x := make([]float64, N)
+	for j := 0; j < N; j++ {
+		x[j] = rng.NormFloat64()
+	}
+	b := []float64{0.5, 0.3, -0.2, 0.1}
+	y := Filter(b, []float64{1}, x)
+	for j := 0; j < N; j++ {
+		y[j] += 0.01 * rng.NormFloat64()
+	}
+
+	// estimate versus DFT of the filter coefficients
+	freqs, h, err := TransferFunction(x, y, fs, 512, 256)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	href := make([]complex128, len(freqs))
+	for k, f := range freqs {
+		for j, bj := range b {
+			href[k] += complex(bj, 0) * cmplx.Exp(complex(0, -2*math.Pi*f*float64(j)/fs))
+		}
+	}
+	chk.ArrayC(tst, "H = DFT(b)", 0.01, h, href)
+
+	// error
+	if _, _, err = TransferFunction(x, y[:10], fs, 512, 256); err == nil {
+		tst.Errorf("different lengths should have caused an error\n")
+	}
+}
+
+func TestDetrend01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Detrend01. constant and linear detrending")
+
+	// constant
+	n := 100
+	x := make([]float64, n)
+	line := make([]float64, n)
+	for j := 0; j < n; j++ {
+		x[j] = 3 + math.Sin(0.3*float64(j))
+		line[j] = -1.5 + 0.25*float64(j)
+	}
+	orig := make([]float64, n)
+	copy(orig, x)
+	y := Detrend(x, "constant")
+	mean := 0.0
+	for j := 0; j < n; j++ {
+		mean += y[j] / float64(n)
+	}
+	chk.Float64(tst, "mean after constant detrend", 1e-15, mean, 0)
+	chk.Array(tst, "x is unchanged", 1e-17, x, orig)
+
+	// linear
+	chk.Array(tst, "line after linear detrend", 1e-13, Detrend(line, "linear"), make([]float64, n))
+
+	// linear detrending of line + oscillation gives zero mean and is idempotent
+	z := make([]float64, n)
+	for j := 0; j < n; j++ {
+		z[j] = line[j] + y[j]
+	}
+	w := Detrend(z, "linear")
+	mean = 0.0
+	for j := 0; j < n; j++ {
+		mean += w[j] / float64(n)
+	}
+	chk.Float64(tst, "mean after linear detrend", 1e-14, mean, 0)
+	chk.Array(tst, "Detrend(Detrend(z))", 1e-13, Detrend(w, "linear"), w)
+
+	// special cases
+	chk.Array(tst, "single point", 1e-17, Detrend([]float64{7}, "linear"), []float64{0})
+	chk.Array(tst, "empty", 1e-17, Detrend(nil, "constant"), []float64{})
+}
@@ -0,0 +1,131 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestSpectralDerivative01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("SpectralDerivative01. derivatives of sin(2πx/L)")
+
+	N, L := 32, 3.0
+	ω := 2 * math.Pi / L
+	h := L / float64(N)
+	x := make([]float64, N)
+	d1 := make([]float64, N)
+	d2 := make([]float64, N)
+	d3 := make([]float64, N)
+	for j := 0; j < N; j++ {
+		t := float64(j) * h
+		x[j] = math.Sin(ω * t)
+		d1[j] = ω * math.Cos(ω*t)
+		d2[j] = -ω * ω * math.Sin(ω*t)
+		d3[j] = -ω * ω * ω * math.Cos(ω*t)
+	}
+	tols := []float64{1e-15, 1e-13, 1e-12, 1e-10} // round-off is amplified by k^order
+	for order, ref := range [][]float64{x, d1, d2, d3} {
+		dx, err := SpectralDerivative(x, L, order)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Array(tst, io.Sf("order %d", order), tols[order], dx, ref)
+	}
+
+	// central finite differences are much less accurate
+	errFD := 0.0
+	for j := 0; j < N; j++ {
+		fd := (x[(j+1)%N] - x[(j-1+N)%N]) / (2 * h)
+		errFD = math.Max(errFD, math.Abs(fd-d1[j]))
+	}
+	io.Pforan("max error of finite differences = %g\n", errFD)
+	if errFD < 1e-3 {
+		tst.Errorf("finite differences should be much less accurate\n")
+		return
+	}
+
+	// errors
+	if _, err := SpectralDerivative(x, L, -1); err == nil {
+		tst.Errorf("order=-1 should have caused an error\n")
+	}
+	if _, err := SpectralDerivative(x, 0, 1); err == nil {
+		tst.Errorf("L=0 should have caused an error\n")
+	}
+	if _, err := SpectralDerivative(x[:10], L, 1); err == nil {
+		tst.Errorf("N=10 should have caused an error\n")
+	}
+}
+
+func TestSpectralDerivative02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("SpectralDerivative02. spectral accuracy with exp(sin(x))")
+
+	// f(x) = exp(sin(x)) on [0, 2π)  ⇒  f'(x) = cos(x) exp(sin(x))
+	for _, N := range []int{8, 16, 32, 64} {
+		x := make([]float64, N)
+		ref := make([]float64, N)
+		for j := 0; j < N; j++ {
+			t := 2 * math.Pi * float64(j) / float64(N)
+			x[j] = math.Exp(math.Sin(t))
+			ref[j] = math.Cos(t) * x[j]
+		}
+		dx, err := SpectralDerivative(x, 2*math.Pi, 1)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		maxerr := 0.0
+		for j := 0; j < N; j++ {
+			maxerr = math.Max(maxerr, math.Abs(dx[j]-ref[j]))
+		}
+		io.Pforan("N = %2d  max error = %g\n", N, maxerr)
+		if N >= 32 {
+			chk.Array(tst, io.Sf("N=%d: f'", N), 1e-12, dx, ref)
+		}
+	}
+}
+
+func TestPoissonFFT1d01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("PoissonFFT1d01. periodic Poisson equation")
+
+	// u = sin(2πx/L) + 0.5 cos(6πx/L)  ⇒  u'' = -ω² sin(ωx) - 4.5 ω² cos(3ωx)
+	N, L := 64, 2.5
+	ω := 2 * math.Pi / L
+	f := make([]float64, N)
+	ref := make([]float64, N)
+	for j := 0; j < N; j++ {
+		t := float64(j) * L / float64(N)
+		ref[j] = math.Sin(ω*t) + 0.5*math.Cos(3*ω*t)
+		f[j] = -ω*ω*math.Sin(ω*t) - 4.5*ω*ω*math.Cos(3*ω*t)
+	}
+	u, err := PoissonFFT1d(f, L)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "u", 1e-13, u, ref)
+
+	// errors
+	f[0] += 1 // non-zero mean
+	if _, err = PoissonFFT1d(f, L); err == nil {
+		tst.Errorf("non-zero mean should have caused an error\n")
+	}
+	if _, err = PoissonFFT1d(f[:10], L); err == nil {
+		tst.Errorf("N=10 should have caused an error\n")
+	}
+	if _, err = PoissonFFT1d(f, -1); err == nil {
+		tst.Errorf("L=-1 should have caused an error\n")
+	}
+}
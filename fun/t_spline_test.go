@@ -0,0 +1,144 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/plt"
+	"github.com/cpmech/gosl/utl"
+)
+
+func TestCubicSpline01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("CubicSpline01. clamped spline reproduces a cubic")
+
+	// f(x) = x³ - 2x² + x + 1 with non-uniform knots
+	f := func(x float64) float64 { return x*x*x - 2*x*x + x + 1 }
+	g := func(x float64) float64 { return 3*x*x - 4*x + 1 }
+	h := func(x float64) float64 { return 6*x - 4 }
+	xs := []float64{-1, -0.3, 0.2, 0.5, 1.4, 2}
+	ys := utl.GetMapped(xs, f)
+	o, err := NewCubicSpline(xs, ys, "clamped", g(xs[0]), g(xs[5]))
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+
+	// check
+	for _, x := range utl.LinSpace(-1, 2, 31) {
+		chk.Float64(tst, io.Sf("S(%5.2f)", x), 1e-14, o.Eval(x), f(x))
+		chk.Float64(tst, io.Sf("S'(%5.2f)", x), 1e-13, o.Deriv(x, 1), g(x))
+		chk.Float64(tst, io.Sf("S''(%5.2f)", x), 1e-13, o.Deriv(x, 2), h(x))
+		chk.Float64(tst, io.Sf("S'''(%5.2f)", x), 1e-12, o.Deriv(x, 3), 6)
+		chk.Float64(tst, io.Sf("S''''(%5.2f)", x), 1e-17, o.Deriv(x, 4), 0)
+	}
+
+	// natural spline reproduces a straight line
+	io.Pl()
+	o, err = NewCubicSpline(xs, utl.GetMapped(xs, func(x float64) float64 { return 3 - 2*x }), "natural")
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for _, x := range []float64{-1.5, -1, 0, 0.33, 1.9, 2.5} {
+		chk.Float64(tst, io.Sf("line(%5.2f)", x), 1e-14, o.Eval(x), 3-2*x)
+	}
+}
+
+func TestCubicSpline02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("CubicSpline02. continuity at knots and natural ends")
+
+	rng := rand.New(rand.NewSource(1234))
+	n := 12
+	xs := make([]float64, n)
+	ys := make([]float64, n)
+	for i := 1; i < n; i++ {
+		xs[i] = xs[i-1] + 0.1 + rng.Float64()
+		ys[i] = math.Sin(xs[i]) + 0.1*rng.Float64()
+	}
+
+	slopes := map[string][]float64{"natural": nil, "clamped": {1, -1}}
+	for _, bc := range []string{"natural", "clamped"} {
+		o, err := NewCubicSpline(xs, ys, bc, slopes[bc]...)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+
+		// interpolation
+		for i := 0; i < n; i++ {
+			chk.Float64(tst, io.Sf("%s: S(x%d)", bc, i), 1e-15, o.Eval(xs[i]), ys[i])
+		}
+
+		// C² continuity: left and right polynomials agree at interior knots
+		for i := 1; i < n-1; i++ {
+			for order := 0; order < 3; order++ {
+				left := o.evalSegment(i-1, xs[i], order)
+				right := o.evalSegment(i, xs[i], order)
+				chk.Float64(tst, io.Sf("%s: order %d at x%d", bc, order, i), 1e-13, left, right)
+			}
+		}
+
+		// end conditions
+		if bc == "natural" {
+			chk.Float64(tst, "S''(x0)", 1e-15, o.Deriv(xs[0], 2), 0)
+			chk.Float64(tst, "S''(xn)", 1e-15, o.Deriv(xs[n-1], 2), 0)
+		} else {
+			chk.Float64(tst, "S'(x0)", 1e-14, o.Deriv(xs[0], 1), 1)
+			chk.Float64(tst, "S'(xn)", 1e-14, o.Deriv(xs[n-1], 1), -1)
+		}
+
+		if chk.Verbose {
+			X := utl.LinSpace(xs[0], xs[n-1], 201)
+			Y := utl.GetMapped(X, func(x float64) float64 { return o.Eval(x) })
+			plt.Reset(true, &plt.A{WidthPt: 400, Dpi: 150})
+			plt.Plot(xs, ys, &plt.A{C: "k", Ls: "none", M: "o", L: "data", NoClip: true})
+			plt.Plot(X, Y, &plt.A{C: "r", Ls: "-", L: bc, NoClip: true})
+			plt.Gll("x", "y", nil)
+			plt.HideTRborders()
+			plt.Save("/tmp/gosl/fun", "cubicspline02-"+bc)
+		}
+	}
+}
+
+func TestCubicSpline03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("CubicSpline03. invalid input")
+
+	xs := []float64{0, 1, 2}
+	if _, err := NewCubicSpline(xs, []float64{0, 1}, "natural"); err == nil {
+		tst.Errorf("different lengths should have caused an error\n")
+		return
+	}
+	if _, err := NewCubicSpline([]float64{0}, []float64{0}, "natural"); err == nil {
+		tst.Errorf("a single knot should have caused an error\n")
+		return
+	}
+	if _, err := NewCubicSpline([]float64{0, 1, 1}, []float64{0, 1, 2}, "natural"); err == nil {
+		tst.Errorf("repeated knots should have caused an error\n")
+		return
+	}
+	if _, err := NewCubicSpline(xs, xs, "periodic"); err == nil {
+		tst.Errorf("unknown boundary condition should have caused an error\n")
+		return
+	}
+	if _, err := NewCubicSpline(xs, xs, "clamped", 1); err == nil {
+		tst.Errorf("a single slope should have caused an error\n")
+		return
+	}
+	if _, err := NewCubicSpline(xs, xs, "natural", 1, 1); err == nil {
+		tst.Errorf("slopes with natural boundary condition should have caused an error\n")
+		return
+	}
+}
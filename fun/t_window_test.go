@@ -0,0 +1,171 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func TestWindow01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Window01. window functions")
+
+	// endpoints and sum of coefficients with n = 9
+	n := 9
+	endpoints := map[string]float64{"hann": 0, "hamming": 0.08, "blackman": 0, "bartlett": 0, "rect": 1}
+	sums := map[string]float64{
+		"hann":     0.5*float64(n) - 0.5,
+		"hamming":  0.54*float64(n) - 0.46,
+		"blackman": 0.42*float64(n) - 0.42,
+		"bartlett": float64(n-1) / 2.0,
+		"rect":     float64(n),
+	}
+	for _, kind := range []string{"hann", "hamming", "blackman", "bartlett", "rect"} {
+		w, err := Window(kind, n)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		io.Pforan("%8s: w = %.4f\n", kind, w)
+		chk.Int(tst, kind+": len(w)", len(w), n)
+		chk.Float64(tst, kind+": w[0]", 1e-15, w[0], endpoints[kind])
+		chk.Float64(tst, kind+": w[n-1]", 1e-15, w[n-1], endpoints[kind])
+		chk.Float64(tst, kind+": w[n/2]", 1e-15, w[n/2], 1)
+		sum := 0.0
+		for k := 0; k < n; k++ {
+			sum += w[k]
+			chk.Float64(tst, io.Sf("%s: symmetry of w[%d]", kind, k), 1e-15, w[k], w[n-1-k])
+		}
+		chk.Float64(tst, kind+": Σw", 1e-14, sum, sums[kind])
+
+		// n = 1
+		w, _ = Window(kind, 1)
+		chk.Array(tst, kind+": n=1", 1e-17, w, []float64{1})
+	}
+
+	// errors
+	if _, err := Window("unknown", n); err == nil {
+		tst.Errorf("unknown kind should have caused an error\n")
+		return
+	}
+	if _, err := Window("hann", 0); err == nil {
+		tst.Errorf("n=0 should have caused an error\n")
+		return
+	}
+}
+
+func TestKaiserWindow01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("KaiserWindow01. Kaiser window")
+
+	// reference values computed with I0 given by its power series
+	chk.Array(tst, "n=9 β=5  ", 1e-14, KaiserWindow(9, 5), []float64{
+		0.03671089227128667, 0.23054433409868882, 0.5528517696991325, 0.8680171594784778, 1.0,
+		0.8680171594784778, 0.5528517696991325, 0.23054433409868882, 0.03671089227128667,
+	})
+	chk.Array(tst, "n=8 β=8.6", 1e-14, KaiserWindow(8, 8.6), []float64{
+		0.0013325139979024196, 0.0911365129282653, 0.4596437745933805, 0.9204615832581569,
+		0.9204615832581569, 0.4596437745933805, 0.0911365129282653, 0.0013325139979024196,
+	})
+	chk.Array(tst, "n=1", 1e-17, KaiserWindow(1, 5), []float64{1})
+	chk.Array(tst, "β=0 (rect)", 1e-17, KaiserWindow(4, 0), []float64{1, 1, 1, 1})
+
+	// symmetry, peak at centre and side-lobe level
+	n, npad := 33, 1024
+	prevLevel := 0.0
+	for _, beta := range []float64{0, 2, 5, 8, 12} {
+		w := KaiserWindow(n, beta)
+		chk.Float64(tst, io.Sf("β=%4.1f: w[n/2]", beta), 1e-15, w[n/2], 1)
+		for k := 0; k < n; k++ {
+			if w[k] != w[n-1-k] {
+				tst.Errorf("β=%g: w[%d] = %v should be equal to w[%d] = %v\n", beta, k, w[k], n-1-k, w[n-1-k])
+				return
+			}
+			if w[k] > 1 {
+				tst.Errorf("β=%g: w[%d] = %v should not be greater than 1\n", beta, k, w[k])
+				return
+			}
+		}
+
+		// spectrum: the main lobe ends at the first local minimum; the side-lobe level is the
+		// largest magnitude after it (relative to the peak)
+		x, err := ZeroPad(w, npad)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		X, err := RealFourierTrans(x)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		dB := MagnitudeDB(X, 1)
+		kmin := 1
+		for kmin < len(dB)-1 && dB[kmin+1] < dB[kmin] {
+			kmin++
+		}
+		level := math.Inf(-1)
+		for k := kmin; k < len(dB); k++ {
+			level = math.Max(level, dB[k]-dB[0])
+		}
+		io.Pf("β=%4.1f: main lobe half-width = %3d bins, side-lobe level = %6.1f dB\n", beta, kmin, level)
+		if beta == 0 {
+			chk.Float64(tst, "side-lobe level of rectangular window", 0.5, level, -13.3)
+		} else if level >= prevLevel {
+			tst.Errorf("β=%g: side-lobe level %g dB should be lower than %g dB\n", beta, level, prevLevel)
+			return
+		}
+		prevLevel = level
+	}
+}
+
+func TestTukeyWindow01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("TukeyWindow01. Tukey (tapered cosine) window")
+
+	// α = 0 and α = 1 give the rectangular and Hann windows
+	for _, n := range []int{1, 2, 8, 9, 64} {
+		rect, _ := Window("rect", n)
+		hann, _ := Window("hann", n)
+		chk.Array(tst, io.Sf("n=%2d: α=0 ⇒ rect", n), 1e-17, TukeyWindow(n, 0), rect)
+		chk.Array(tst, io.Sf("n=%2d: α=1 ⇒ hann", n), 1e-15, TukeyWindow(n, 1), hann)
+	}
+
+	// scipy.signal.windows.tukey(11, 0.5)
+	chk.Array(tst, "n=11 α=0.5", 1e-15, TukeyWindow(11, 0.5), []float64{
+		0, 0.3454915028125263, 0.9045084971874737, 1, 1, 1, 1, 1, 0.9045084971874737, 0.3454915028125263, 0,
+	})
+
+	// flat region and symmetry
+	n := 101
+	w := TukeyWindow(n, 0.2)
+	for k := 0; k < n; k++ {
+		if k >= 10 && k <= 90 && w[k] != 1 {
+			tst.Errorf("w[%d] = %v should be equal to 1 in the flat region\n", k, w[k])
+			return
+		}
+		if w[k] != w[n-1-k] {
+			tst.Errorf("w[%d] = %v should be equal to w[%d] = %v\n", k, w[k], n-1-k, w[n-1-k])
+			return
+		}
+	}
+
+	// invalid alpha
+	defer func() {
+		if err := recover(); err != nil {
+			io.Pf("OK: alpha=1.5 caused panic: %v\n", err)
+		} else {
+			tst.Errorf("alpha=1.5 should have caused a panic\n")
+		}
+	}()
+	TukeyWindow(8, 1.5)
+}
@@ -0,0 +1,128 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// Window returns the n coefficients of a (symmetric) window function for spectral analysis
+//
+//   kind -- type of window:
+//             "hann"     -- w[k] = 0.5 - 0.5 cos(2πk/(n-1))
+//             "hamming"  -- w[k] = 0.54 - 0.46 cos(2πk/(n-1))
+//             "blackman" -- w[k] = 0.42 - 0.5 cos(2πk/(n-1)) + 0.08 cos(4πk/(n-1))
+//             "bartlett" -- w[k] = 1 - |2k/(n-1) - 1|  (triangular with zero endpoints)
+//             "rect"     -- w[k] = 1                   (rectangular; i.e. no window)
+//   n    -- number of coefficients; n = 1 gives w = [1]
+//
+//   NOTE: the signal should be multiplied by the window before computing the FFT;
+//         e.g. x[k] ⋅ w[k]. The definitions follow numpy.hanning, numpy.hamming, etc.
+//
+func Window(kind string, n int) (w []float64, err error) {
+
+	// check
+	if n < 1 {
+		return nil, chk.Err("the number of coefficients must be at least 1. n = %d is invalid", n)
+	}
+	var f func(θ float64) float64 // θ = 2πk/(n-1) ∈ [0, 2π]
+	switch kind {
+	case "hann":
+		f = func(θ float64) float64 { return 0.5 - 0.5*math.Cos(θ) }
+	case "hamming":
+		f = func(θ float64) float64 { return 0.54 - 0.46*math.Cos(θ) }
+	case "blackman":
+		f = func(θ float64) float64 { return 0.42 - 0.5*math.Cos(θ) + 0.08*math.Cos(2*θ) }
+	case "bartlett":
+		f = func(θ float64) float64 { return 1 - math.Abs(θ/math.Pi-1) }
+	case "rect":
+		f = func(θ float64) float64 { return 1 }
+	default:
+		return nil, chk.Err("window kind %q is invalid. Options are \"hann\", \"hamming\", \"blackman\", \"bartlett\" and \"rect\"", kind)
+	}
+
+	// compute coefficients
+	w = make([]float64, n)
+	if n == 1 {
+		w[0] = 1
+		return
+	}
+	for k := 0; k < n; k++ {
+		w[k] = f(2.0 * math.Pi * float64(k) / float64(n-1))
+	}
+	w[0], w[n-1] = f(0), f(0) // exact symmetry (θ = 2π with roundoff)
+	return
+}
+
+// KaiserWindow returns the n coefficients of the (symmetric) Kaiser window
+//
+//                  _______________________
+//           I0( β √ 1 - (2k/(n-1) - 1)²  )
+//   w[k] = ————————————————————————————————      k = 0 ... n-1
+//                       I0(β)
+//
+//   n    -- number of coefficients; n = 1 gives w = [1]
+//   beta -- shape parameter β ≥ 0. β = 0 gives the rectangular window; larger values widen the
+//           main lobe and lower the side lobes of the spectrum (e.g. β ≈ 8.6 is similar to the
+//           Blackman window)
+//
+//   NOTE: I0 is the modified Bessel function of the first kind of order 0; see BesselI.
+//         The definition follows numpy.kaiser
+//
+func KaiserWindow(n int, beta float64) (w []float64) {
+	if n < 1 {
+		chk.Panic("the number of coefficients must be at least 1. n = %d is invalid\n", n)
+	}
+	if beta < 0 {
+		chk.Panic("the shape parameter must be non-negative. beta = %g is invalid\n", beta)
+	}
+	w = make([]float64, n)
+	if n == 1 {
+		w[0] = 1
+		return
+	}
+	den := BesselI(0, beta)
+	for k := 0; k < (n+1)/2; k++ {
+		r := 2.0*float64(k)/float64(n-1) - 1
+		w[k] = BesselI(0, beta*math.Sqrt(1-r*r)) / den
+		w[n-1-k] = w[k] // exact symmetry
+	}
+	return
+}
+
+// TukeyWindow returns the n coefficients of the (symmetric) Tukey (tapered cosine) window
+//
+//            ⎧ 0.5 - 0.5 cos(2π x / α)    if x < α/2
+//   w[k] =   ⎨ 1                          if α/2 ≤ x ≤ 1 - α/2       with x = k/(n-1)
+//            ⎩ w[n-1-k]                   if x > 1 - α/2
+//
+//   n     -- number of coefficients; n = 1 gives w = [1]
+//   alpha -- fraction α ∈ [0, 1] of the window inside the cosine tapers. α = 0 gives the
+//            rectangular window and α = 1 gives the Hann window
+//
+//   NOTE: the definition follows scipy.signal.windows.tukey
+//
+func TukeyWindow(n int, alpha float64) (w []float64) {
+	if n < 1 {
+		chk.Panic("the number of coefficients must be at least 1. n = %d is invalid\n", n)
+	}
+	if alpha < 0 || alpha > 1 {
+		chk.Panic("the taper fraction must be in [0, 1]. alpha = %g is invalid\n", alpha)
+	}
+	w = make([]float64, n)
+	for k := 0; k < (n+1)/2; k++ {
+		w[k] = 1
+		if n > 1 {
+			x := float64(k) / float64(n-1)
+			if x < alpha/2 {
+				w[k] = 0.5 - 0.5*math.Cos(2.0*math.Pi*x/alpha)
+			}
+		}
+		w[n-1-k] = w[k] // exact symmetry
+	}
+	return
+}
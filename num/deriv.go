@@ -7,6 +7,7 @@ package num
 import (
 	"math"
 
+	"github.com/cpmech/gosl/chk"
 	"github.com/cpmech/gosl/fun"
 	"github.com/cpmech/gosl/utl"
 )
@@ -117,6 +118,63 @@ func SecondDerivMixedO4v2(x, y, h float64, f fun.Sss) float64 {
 	return (8.0*k - 8.0*l - m + 64.0*n) / (144.0 * h * h)
 }
 
+// Derivative approximates the derivative df/dx at x using central differences with Richardson
+// extrapolation. It is equivalent to DerivativeN(f, x, h, 1)
+//
+//   h -- initial step size; if h ≤ 0, the default value h = 0.1 is used
+//
+func Derivative(f fun.Ss, x, h float64) float64 {
+	return DerivativeN(f, x, h, 1)
+}
+
+// DerivativeN approximates the derivative dⁿf/dxⁿ (n = order) at x using central differences
+// with Richardson extrapolation
+//
+//   The central difference of order n with step h is:
+//
+//                  1    n
+//        Dₕ f(x) = ——    Σ   (-1)ᵏ ⋅ C(n,k) ⋅ f(x + (n/2 - k)⋅h)
+//                  hⁿ   k=0
+//
+//   where C(n,k) are the binomial coefficients. The error of Dₕ is O(h²) with only even powers
+//   of h; thus the estimates with h, h/2, h/4 and h/8 are combined by Richardson extrapolation,
+//   giving an error of O(h⁸).
+//
+//   Input:
+//     f     -- function
+//     x     -- point where the derivative is computed
+//     h     -- initial step size. If h ≤ 0, the default value h = 0.1⋅2^(n-1) is used
+//     order -- order of the derivative ≥ 0; order = 0 returns f(x)
+//
+//   NOTE: (1) the rounding error grows as ε/hⁿ; thus larger steps are needed for higher orders
+//         (2) the default step assumes that f varies on a unit scale; otherwise, h should be
+//             given according to the scale of f
+//
+func DerivativeN(f fun.Ss, x, h float64, order int) float64 {
+	if order < 0 {
+		chk.Panic("order of derivative must be non-negative. order = %d is invalid\n", order)
+	}
+	if order == 0 {
+		return f(x)
+	}
+	if h <= 0 {
+		h = 0.1 * math.Pow(2, float64(order-1))
+	}
+	const nlevels = 4
+	var R [nlevels]float64
+	for i := 0; i < nlevels; i++ {
+		prev := R[0]
+		R[0] = centralDiffN(f, x, h, order)
+		p := 1.0
+		for j := 1; j <= i; j++ {
+			p *= 4
+			prev, R[j] = R[j], R[j-1]+(R[j-1]-prev)/(p-1)
+		}
+		h /= 2
+	}
+	return R[nlevels-1]
+}
+
 // lower level functions //////////////////////////////////////////////////////////////////////////
 
 // centralDeriv5 computes the derivative using the 5-point rule (x-h, x-h/2, x, x+h/2, x+h).
@@ -179,3 +237,13 @@ func forwardDeriv4(x, h float64, f fun.Ss) (res, absErrRound, absErrTrunc float6
 	absErrRound = math.Abs(e4/h) + dy
 	return
 }
+
+// centralDiffN computes the central difference of order n with step h (see DerivativeN)
+func centralDiffN(f fun.Ss, x, h float64, n int) (res float64) {
+	c := 1.0 // (-1)ᵏ binomial(n, k)
+	for k := 0; k <= n; k++ {
+		res += c * f(x+(float64(n)/2-float64(k))*h)
+		c *= -float64(n-k) / float64(k+1)
+	}
+	return res / math.Pow(h, float64(n))
+}
@@ -49,6 +49,52 @@ func Jacobian(J *la.Triplet, ffcn fun.Vv, x, fx, w []float64) {
 	return
 }
 
+// JacobianFD computes the (dense) m×n Jacobian matrix J[i][j] = ∂fᵢ/∂xⱼ of a vector function by
+// means of central finite differences
+//
+//   Input:
+//     f -- vector function returning m = len(f(x)) values; f must not retain its input slice
+//     x -- station where df/dx has to be calculated; len(x) = n. x is not modified
+//     h -- step size; if h ≤ 0, the step δⱼ = ∛ε ⋅ max(1, |xⱼ|) is used for each component
+//
+//   Output:
+//     J -- newly allocated m×n matrix with the derivatives
+//
+//   NOTE: f is first evaluated at x to determine m; an error is returned if any subsequent
+//         evaluation returns a different number of values
+//
+func JacobianFD(f func(x []float64) []float64, x []float64, h float64) (J [][]float64, err error) {
+	n := len(x)
+	if n == 0 {
+		return nil, chk.Err("x must not be empty")
+	}
+	m := len(f(x))
+	if m == 0 {
+		return nil, chk.Err("f(x) must return at least one value")
+	}
+	J = utl.Alloc(m, n)
+	xx := make([]float64, n)
+	copy(xx, x)
+	for j := 0; j < n; j++ {
+		δ := h
+		if h <= 0 {
+			δ = math.Cbrt(MACHEPS) * utl.Max(1, math.Abs(x[j]))
+		}
+		xx[j] = x[j] + δ
+		fp := f(xx)
+		xx[j] = x[j] - δ
+		fm := f(xx)
+		xx[j] = x[j]
+		if len(fp) != m || len(fm) != m {
+			return nil, chk.Err("f returned %d and %d values when perturbing x[%d], but f(x) returned %d values", len(fp), len(fm), j, m)
+		}
+		for i := 0; i < m; i++ {
+			J[i][j] = (fp[i] - fm[i]) / (2 * δ)
+		}
+	}
+	return
+}
+
 // CompareJac compares Jacobian matrix (e.g. for testing)
 func CompareJac(tst *testing.T, ffcn fun.Vv, Jfcn fun.Tv, x []float64, tol float64) {
 
@@ -0,0 +1,69 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package num
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/fun"
+)
+
+// AdaptiveSimpson approximates the integral of f(x) in [a, b] using the adaptive Simpson's
+// method. Each interval is recursively bisected until the difference between the Simpson
+// estimates on the whole interval (S) and on its two halves (S₂) satisfies |S₂ - S| ≤ 15 tol,
+// where tol is halved at each subdivision.
+//
+//   Input:
+//     f        -- function to be integrated
+//     a, b     -- limits of integration
+//     tol      -- absolute tolerance > 0
+//     maxDepth -- maximum number of subdivisions (recursion depth) ≥ 1
+//
+//   Output:
+//     res -- the integral estimate including the Richardson correction (S₂ - S)/15
+//     err -- an error is returned if maxDepth is reached before the tolerance is met in any
+//            subinterval; res then holds the estimate computed so far
+//
+//   NOTE: intervals with localised features are refined more than the others; thus fewer
+//         function evaluations are required than with uniform Simpson's rules
+//
+func AdaptiveSimpson(f fun.Ss, a, b, tol float64, maxDepth int) (res float64, err error) {
+	if tol <= 0 {
+		return 0, chk.Err("tolerance must be positive. tol = %g is invalid", tol)
+	}
+	if maxDepth < 1 {
+		return 0, chk.Err("maximum depth must be at least 1. maxDepth = %d is invalid", maxDepth)
+	}
+	fa, fm, fb := f(a), f(0.5*(a+b)), f(b)
+	whole := (b - a) / 6 * (fa + 4*fm + fb)
+	var ok bool
+	res, ok = adaptiveSimpsonStep(f, a, b, fa, fm, fb, whole, tol, maxDepth)
+	if !ok {
+		err = chk.Err("maximum depth = %d reached before the tolerance = %g was met", maxDepth, tol)
+	}
+	return
+}
+
+// adaptiveSimpsonStep performs one step of the adaptive Simpson's method on [a, b] where fa,
+// fm and fb are the values of the function at a, (a+b)/2 and b, and whole is the Simpson estimate
+// on [a, b]. The flag ok is false if the depth was exhausted somewhere in [a, b]
+func adaptiveSimpsonStep(f fun.Ss, a, b, fa, fm, fb, whole, tol float64, depth int) (res float64, ok bool) {
+	m := 0.5 * (a + b)
+	lm, rm := 0.5*(a+m), 0.5*(m+b)
+	flm, frm := f(lm), f(rm)
+	left := (m - a) / 6 * (fa + 4*flm + fm)
+	right := (b - m) / 6 * (fm + 4*frm + fb)
+	delta := left + right - whole
+	if math.Abs(delta) <= 15*tol {
+		return left + right + delta/15, true
+	}
+	if depth <= 1 {
+		return left + right + delta/15, false
+	}
+	resL, okL := adaptiveSimpsonStep(f, a, m, fa, flm, fm, left, tol/2, depth-1)
+	resR, okR := adaptiveSimpsonStep(f, m, b, fm, frm, fb, right, tol/2, depth-1)
+	return resL + resR, okL && okR
+}
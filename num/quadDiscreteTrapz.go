@@ -21,6 +21,79 @@ func QuadDiscreteTrapzXY(x, y []float64) (A float64) {
 	return
 }
 
+// Trapz integrates equally spaced samples y with the trapezoidal rule
+//
+//                ⎛ y[0]                          y[n-1] ⎞
+//     A  =  dx ⋅ ⎜ ———— + y[1] + … + y[n-2] + ——————— ⎟
+//                ⎝  2                            2     ⎠
+//
+//   y  -- sampled data; e.g. experimental data. If len(y) < 2, A = 0
+//   dx -- spacing of samples
+//
+func Trapz(y []float64, dx float64) (A float64) {
+	n := len(y)
+	if n < 2 {
+		return 0
+	}
+	A = (y[0] + y[n-1]) / 2.0
+	for i := 1; i < n-1; i++ {
+		A += y[i]
+	}
+	return A * dx
+}
+
+// TrapzX integrates the samples y at the (non-uniformly spaced) points x with the trapezoidal rule
+//
+//   x -- coordinates of samples; len(x) = len(y). If len(x) < 2, A = 0
+//   y -- sampled data
+//
+//   NOTE: this function is equivalent to QuadDiscreteTrapzXY, but returns an error instead of
+//         panicking if the lengths of x and y are different
+//
+func TrapzX(x, y []float64) (A float64, err error) {
+	if len(x) != len(y) {
+		return 0, chk.Err("length of x and y must be the same. %d != %d", len(x), len(y))
+	}
+	for i := 1; i < len(x); i++ {
+		A += (x[i] - x[i-1]) * (y[i] + y[i-1]) / 2.0
+	}
+	return
+}
+
+// CumTrapz computes the cumulative integral of equally spaced samples y with the trapezoidal rule
+//
+//     c[0] = 0   and   c[i] = c[i-1] + dx ⋅ (y[i-1] + y[i]) / 2       i = 1 ... n-1
+//
+//   y  -- sampled data; e.g. velocities to be converted into positions
+//   dx -- spacing of samples
+//
+//   NOTE: len(c) = len(y) and c[n-1] = Trapz(y, dx)
+//
+func CumTrapz(y []float64, dx float64) (c []float64) {
+	c = make([]float64, len(y))
+	for i := 1; i < len(y); i++ {
+		c[i] = c[i-1] + dx*(y[i-1]+y[i])/2.0
+	}
+	return
+}
+
+// CumTrapzX computes the cumulative integral of the samples y at the (non-uniformly spaced)
+// points x with the trapezoidal rule; see CumTrapz
+//
+//   x -- coordinates of samples; len(x) = len(y)
+//   y -- sampled data
+//
+func CumTrapzX(x, y []float64) (c []float64, err error) {
+	if len(x) != len(y) {
+		return nil, chk.Err("length of x and y must be the same. %d != %d", len(x), len(y))
+	}
+	c = make([]float64, len(y))
+	for i := 1; i < len(y); i++ {
+		c[i] = c[i-1] + (x[i]-x[i-1])*(y[i-1]+y[i])/2.0
+	}
+	return
+}
+
 // QuadDiscreteTrapzXF approximates the area below the discrete curve defined by x points and y
 // function. Computations are carried out with the (very simple) trapezoidal rule.
 func QuadDiscreteTrapzXF(x []float64, y fun.Ss) (A float64) {
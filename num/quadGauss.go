@@ -46,44 +46,26 @@ func QuadGaussL10(a, b float64, f fun.Ss) (res float64) {
 //     x1 -- lower limit of integration
 //     x2 -- upper limit of integration
 //     n  -- number of points for quadrature formula
+//   NOTE: the nodes and weights in [-1, 1] are computed by GaussLegendre and then scaled to
+//         [x1, x2]; the code panics if Newton's method does not converge
 //   Reference:
 //   [1] Press WH, Teukolsky SA, Vetterling WT, Fnannery BP (2007) Numerical Recipes: The Art of
 //       Scientific Computing. Third Edition. Cambridge University Press. 1235p.
 func GaussLegendreXW(x1, x2 float64, n int) (x, w []float64) {
+	if n == 0 {
+		return []float64{}, []float64{}
+	}
+	nodes, weights, err := GaussLegendre(n)
+	if err != nil {
+		chk.Panic("%v\n", err)
+	}
 	x = make([]float64, n)
 	w = make([]float64, n)
-	EPS := MACHEPS // relative precision.
-	var z1, z, xm, xl, pp, p3, p2, p1 float64
-	m := (n + 1) / 2 // The roots are symmetric in the interval, so we only have to find half of them.
-	xm = 0.5 * (x2 + x1)
-	xl = 0.5 * (x2 - x1)
-	for i := 0; i < m; i++ { // Loop over the desired roots.
-		z = math.Cos(3.141592654 * (float64(i) + 0.75) / (float64(n) + 0.5))
-		// starting with this approximation to the ith root, we enter the main loop of refinement by Newton's method.
-		it, MAXIT := 0, 10
-		for it := 0; it < MAXIT; it++ {
-			p1 = 1.0
-			p2 = 0.0
-			for j := 0; j < n; j++ { // Loop up the recurrence relation to get the Legendre polynomial evaluated at z.
-				p3 = p2
-				p2 = p1
-				p1 = ((2.0*float64(j)+1.0)*z*p2 - float64(j)*p3) / (float64(j) + 1.0)
-			}
-			// p1 is now the desired Legendre polynomial. We next compute pp, its derivative, by a standard relation involving also p2, the polynomial of one lower order.
-			pp = float64(n) * (z*p1 - p2) / (z*z - 1.0)
-			z1 = z
-			z = z1 - p1/pp // Newton's method.
-			if math.Abs(z-z1) < EPS {
-				break
-			}
-		}
-		if it == MAXIT {
-			chk.Panic("Newton's method did not converge after %d iterations", it)
-		}
-		x[i] = xm - xl*z // Scale the root to the desired interval, and put in its symmetric counterpart.
-		x[n-1-i] = xm + xl*z
-		w[i] = 2.0 * xl / ((1.0 - z*z) * pp * pp) //  Compute the weight and its symmetric counterpart.
-		w[n-1-i] = w[i]
+	xm := 0.5 * (x2 + x1)
+	xl := 0.5 * (x2 - x1)
+	for i := 0; i < n; i++ {
+		x[i] = xm + xl*nodes[i]
+		w[i] = xl * weights[i]
 	}
 	return
 }
@@ -175,3 +157,244 @@ func GaussJacobiXW(alf, bet float64, n int) (x, w []float64) {
 	utl.Qsort2(x, w)
 	return
 }
+
+// GaussLegendre computes the nodes and weights of the n-point Gauss-Legendre quadrature rule
+// in [-1, 1]; i.e.
+//
+//        1           n-1
+//        ∫ f(x) dx ≈  Σ  weights[i] ⋅ f(nodes[i])
+//       -1           i=0
+//
+//   Input:
+//     n -- number of points ≥ 1. The rule is exact for polynomials of degree up to 2n-1
+//
+//   Output:
+//     nodes   -- roots of the Legendre polynomial Pn(x) in ascending order
+//     weights -- corresponding weights: w = 2 / ((1-x²) ⋅ Pn'(x)²)
+//
+//   NOTE: (1) the roots are found by Newton's method starting from the approximation
+//             x ≈ cos(π (i + 3/4) / (n + 1/2)) and the symmetry of the roots is used
+//         (2) GaussLegendreXW scales these nodes and weights to an arbitrary interval [x1, x2]
+//
+func GaussLegendre(n int) (nodes, weights []float64, err error) {
+	if n < 1 {
+		return nil, nil, chk.Err("number of points must be at least 1. n = %d is invalid", n)
+	}
+	nodes = make([]float64, n)
+	weights = make([]float64, n)
+	N := float64(n)
+	maxIt := 100
+	for i := 0; i < (n+1)/2; i++ {
+		z := math.Cos(math.Pi * (float64(i) + 0.75) / (N + 0.5))
+		it := 0
+		for ; it < maxIt; it++ {
+			p, pp := legendreAndDeriv(n, z)
+			dz := p / pp
+			z -= dz
+			if math.Abs(dz) <= 1e-15 {
+				break
+			}
+		}
+		if it == maxIt {
+			return nil, nil, chk.Err("Newton's method did not converge after %d iterations (root %d of P%d)", it, i, n)
+		}
+		_, pp := legendreAndDeriv(n, z)
+		nodes[i], nodes[n-1-i] = -z, z
+		weights[i] = 2 / ((1 - z*z) * pp * pp)
+		weights[n-1-i] = weights[i]
+	}
+	return
+}
+
+// IntegrateGL approximates the integral of f(x) in [a, b] using the n-point Gauss-Legendre rule
+//
+//        b           b - a  n-1                 b - a        a + b
+//        ∫ f(x) dx ≈ —————   Σ  w[i] ⋅ f(x) ,   x = ————— ξ[i] + —————
+//        a             2    i=0                   2            2
+//
+//   NOTE: the nodes and weights are computed by GaussLegendre on each call; the code panics if n < 1
+//
+func IntegrateGL(f fun.Ss, a, b float64, n int) (res float64) {
+	nodes, weights, err := GaussLegendre(n)
+	if err != nil {
+		chk.Panic("%v\n", err)
+	}
+	xm := 0.5 * (b + a)
+	xr := 0.5 * (b - a)
+	for i, ξ := range nodes {
+		res += weights[i] * f(xm+xr*ξ)
+	}
+	res *= xr
+	return
+}
+
+// GaussHermite computes the nodes and weights of the n-point Gauss-Hermite quadrature rule; i.e.
+//
+//        +∞                        n-1
+//        ∫ exp(-x²) f(x) dx   ≈    Σ  weights[i] ⋅ f(nodes[i])
+//        -∞                        i=0
+//
+//   Input:
+//     n -- number of points ≥ 1. The rule is exact for polynomials f of degree up to 2n-1
+//
+//   Output:
+//     nodes   -- roots of the Hermite polynomial Hn(x) in ascending order
+//     weights -- corresponding weights: w = 2 / H̃n'(x)² where H̃n are the orthonormal Hermite
+//                polynomials (with respect to the weight exp(-x²))
+//
+//   NOTE: the roots are found by Newton's method with the initial guesses of [1]; the orthonormal
+//         polynomials are used to avoid overflow and the symmetry of the roots is used
+//
+//   Reference:
+//   [1] Press WH, Teukolsky SA, Vetterling WT, Fnannery BP (2007) Numerical Recipes: The Art of
+//       Scientific Computing. Third Edition. Cambridge University Press. 1235p.
+//
+func GaussHermite(n int) (nodes, weights []float64, err error) {
+	if n < 1 {
+		return nil, nil, chk.Err("number of points must be at least 1. n = %d is invalid", n)
+	}
+	nodes = make([]float64, n)
+	weights = make([]float64, n)
+	N := float64(n)
+	maxIt := 100
+	var z float64
+	for i := 0; i < (n+1)/2; i++ {
+		switch i {
+		case 0:
+			z = math.Sqrt(2*N+1) - 1.85575*math.Pow(2*N+1, -0.16667)
+		case 1:
+			z -= 1.14 * math.Pow(N, 0.426) / z
+		case 2:
+			z = 1.86*z - 0.86*nodes[n-1]
+		case 3:
+			z = 1.91*z - 0.91*nodes[n-2]
+		default:
+			z = 2.0*z - nodes[n-1-(i-2)]
+		}
+		it := 0
+		var pp float64
+		for ; it < maxIt; it++ {
+			var p float64
+			p, pp = hermiteOrthonormal(n, z)
+			dz := p / pp
+			z -= dz
+			if math.Abs(dz) <= 1e-15*math.Max(1, math.Abs(z)) {
+				break
+			}
+		}
+		if it == maxIt {
+			return nil, nil, chk.Err("Newton's method did not converge after %d iterations (root %d of H%d)", it, i, n)
+		}
+		_, pp = hermiteOrthonormal(n, z)
+		nodes[i], nodes[n-1-i] = -z, z
+		weights[i] = 2 / (pp * pp)
+		weights[n-1-i] = weights[i]
+	}
+	if n%2 == 1 {
+		nodes[n/2] = 0 // exact
+	}
+	return
+}
+
+// GaussLaguerre computes the nodes and weights of the n-point generalised Gauss-Laguerre
+// quadrature rule; i.e.
+//
+//        ∞                             n-1
+//        ∫ xᵅ exp(-x) f(x) dx   ≈      Σ  weights[i] ⋅ f(nodes[i])
+//        0                             i=0
+//
+//   Input:
+//     n     -- number of points ≥ 1. The rule is exact for polynomials f of degree up to 2n-1
+//     alpha -- exponent of the weight function; alpha > -1 (alpha = 0 gives the standard rule)
+//
+//   Output:
+//     nodes   -- roots of the generalised Laguerre polynomial Lnᵅ(x) in ascending order
+//     weights -- corresponding weights: w = -Γ(n+α) / (Γ(n) ⋅ n ⋅ Lnᵅ'(x) ⋅ Lₙ₋₁ᵅ(x))
+//
+//   NOTE: the roots are found by Newton's method with the initial guesses of [1]
+//
+//   Reference:
+//   [1] Press WH, Teukolsky SA, Vetterling WT, Fnannery BP (2007) Numerical Recipes: The Art of
+//       Scientific Computing. Third Edition. Cambridge University Press. 1235p.
+//
+func GaussLaguerre(n int, alpha float64) (nodes, weights []float64, err error) {
+	if n < 1 {
+		return nil, nil, chk.Err("number of points must be at least 1. n = %d is invalid", n)
+	}
+	if alpha <= -1 {
+		return nil, nil, chk.Err("alpha must be greater than -1. alpha = %g is invalid", alpha)
+	}
+	nodes = make([]float64, n)
+	weights = make([]float64, n)
+	N := float64(n)
+	lg1, _ := math.Lgamma(alpha + N)
+	lg2, _ := math.Lgamma(N)
+	maxIt := 100
+	var z float64
+	for i := 0; i < n; i++ {
+		switch i {
+		case 0:
+			z = (1 + alpha) * (3 + 0.92*alpha) / (1 + 2.4*N + 1.8*alpha)
+		case 1:
+			z += (15 + 6.25*alpha) / (1 + 0.9*alpha + 2.5*N)
+		default:
+			ai := float64(i - 1)
+			z += ((1+2.55*ai)/(1.9*ai) + 1.26*ai*alpha/(1+3.5*ai)) * (z - nodes[i-2]) / (1 + 0.3*alpha)
+		}
+		it := 0
+		var p, pPrev, pp float64
+		for ; it < maxIt; it++ {
+			p, pPrev = laguerreGen(n, alpha, z)
+			pp = (N*p - (N+alpha)*pPrev) / z
+			dz := p / pp
+			z -= dz
+			if math.Abs(dz) <= 1e-15*math.Max(1, math.Abs(z)) {
+				break
+			}
+		}
+		if it == maxIt {
+			return nil, nil, chk.Err("Newton's method did not converge after %d iterations (root %d of L%d)", it, i, n)
+		}
+		p, pPrev = laguerreGen(n, alpha, z)
+		pp = (N*p - (N+alpha)*pPrev) / z
+		nodes[i] = z
+		weights[i] = -math.Exp(lg1-lg2) / (pp * N * pPrev)
+	}
+	return
+}
+
+// hermiteOrthonormal computes the orthonormal Hermite polynomial H̃n(x) = Hn(x) / √(√π 2ⁿ n!)
+// and its derivative using the recurrence
+//   H̃[j+1] = x √(2/(j+1)) H̃[j] - √(j/(j+1)) H̃[j-1]   with H̃[0] = π^(-1/4)
+func hermiteOrthonormal(n int, x float64) (p, dpdx float64) {
+	p, pPrev := math.Pow(math.Pi, -0.25), 0.0
+	for j := 0; j < n; j++ {
+		J := float64(j)
+		p, pPrev = x*math.Sqrt(2/(J+1))*p-math.Sqrt(J/(J+1))*pPrev, p
+	}
+	dpdx = math.Sqrt(2*float64(n)) * pPrev
+	return
+}
+
+// laguerreGen computes the generalised Laguerre polynomials Lnᵅ(x) and Lₙ₋₁ᵅ(x) using the
+// recurrence (j+1) L[j+1] = (2j + 1 + α - x) L[j] - (j + α) L[j-1]
+func laguerreGen(n int, alpha, x float64) (p, pPrev float64) {
+	p, pPrev = 1.0, 0.0
+	for j := 0; j < n; j++ {
+		J := float64(j)
+		p, pPrev = ((2*J+1+alpha-x)*p-(J+alpha)*pPrev)/(J+1), p
+	}
+	return
+}
+
+// legendreAndDeriv computes the Legendre polynomial Pn(x) and its derivative using the
+// three-term recurrence (j+1) P[j+1] = (2j+1) x P[j] - j P[j-1]
+func legendreAndDeriv(n int, x float64) (p, dpdx float64) {
+	p, pPrev := 1.0, 0.0
+	for j := 0; j < n; j++ {
+		J := float64(j)
+		p, pPrev = ((2*J+1)*x*p-J*pPrev)/(J+1), p
+	}
+	dpdx = float64(n) * (x*p - pPrev) / (x*x - 1)
+	return
+}
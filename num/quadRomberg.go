@@ -0,0 +1,71 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package num
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/fun"
+)
+
+// Romberg approximates the integral of f(x) in [a, b] using Romberg's method; i.e. Richardson
+// extrapolation applied to the sequence of trapezoidal rules with 1, 2, 4, ..., 2ⁱ intervals
+//
+//                                 R[i][j-1] - R[i-1][j-1]
+//     R[i][j] = R[i][j-1]  +  ———————————————————————————
+//                                       4ʲ - 1
+//
+//   Input:
+//     f       -- function to be integrated
+//     a, b    -- limits of integration
+//     maxIter -- maximum number of rows of the table ≥ 2; the last row uses 2^(maxIter-1)
+//                intervals
+//     tol     -- absolute tolerance; the iterations stop when |R[i][i] - R[i-1][i-1]| ≤ tol
+//
+//   Output:
+//     res -- the last diagonal estimate R[i][i]
+//     err -- an error is returned if the tolerance is not met after maxIter rows; res then holds
+//            the last estimate
+//
+//   NOTE: (1) only the last two rows of the table are stored
+//         (2) the test is only performed from the third row on (i ≥ 2) to avoid accidental
+//             agreement of the first coarse estimates
+//
+func Romberg(f fun.Ss, a, b float64, maxIter int, tol float64) (res float64, err error) {
+	if maxIter < 2 {
+		return 0, chk.Err("maximum number of iterations must be at least 2. maxIter = %d is invalid", maxIter)
+	}
+	prev := make([]float64, maxIter)
+	curr := make([]float64, maxIter)
+	h := b - a
+	curr[0] = 0.5 * h * (f(a) + f(b))
+	n := 1 // number of intervals
+	for i := 1; i < maxIter; i++ {
+		prev, curr = curr, prev
+
+		// refined trapezoidal rule: add the midpoints of the current n intervals
+		sum := 0.0
+		for k := 0; k < n; k++ {
+			sum += f(a + (float64(k)+0.5)*h)
+		}
+		curr[0] = 0.5 * (prev[0] + h*sum)
+		h /= 2
+		n *= 2
+
+		// Richardson extrapolation
+		p := 1.0
+		for j := 1; j <= i; j++ {
+			p *= 4
+			curr[j] = curr[j-1] + (curr[j-1]-prev[j-1])/(p-1)
+		}
+		res = curr[i]
+		if i >= 2 && math.Abs(res-prev[i-1]) <= tol {
+			return
+		}
+	}
+	err = chk.Err("Romberg's method did not converge after %d iterations (tol = %g)", maxIter, tol)
+	return
+}
@@ -0,0 +1,116 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package num
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/fun"
+)
+
+// TanhSinh approximates the integral of f(x) in [a, b] using the tanh-sinh (double exponential)
+// quadrature of Takahasi and Mori [1]
+//
+//   With the change of variables x = c + d tanh(π/2 sinh t), c = (a+b)/2 and d = (b-a)/2:
+//
+//        b           ∞                                   π/2 cosh t
+//        ∫ f(x) dx = ∫ f(x(t)) w(t) dt     with   w = d ———————————————
+//        a          -∞                                  cosh²(π/2 sinh t)
+//
+//   and the integral in t is computed by the trapezoidal rule with step h. The weights decay
+//   double exponentially; thus the truncated trapezoidal rule converges very rapidly, even if
+//   f has integrable singularities at a or b
+//
+//   Input:
+//     f    -- function to be integrated; f is never evaluated at a or b
+//     a, b -- finite limits of integration
+//     tol  -- absolute tolerance > 0
+//
+//   Output:
+//     res -- the integral estimate
+//     err -- an error is returned if the difference between the estimates with steps h and h/2
+//            is still greater than tol with h = 2⁻¹²; res then holds the last estimate
+//
+//   NOTE: (1) the computation starts with h = 1 and the step is halved until the tolerance is
+//             met; the points of the previous levels are reused
+//         (2) the distances to the ends are computed without cancellation; nonetheless, the
+//             points are limited by the floating-point resolution near a and b. Thus, for
+//             singularities, it is best to have the singular end at 0; e.g. ∫₀¹ (1-x)^(-½) dx
+//             should be computed as ∫₀¹ x^(-½) dx
+//
+//   Reference:
+//   [1] Takahasi H, Mori M (1974) Double exponential formulas for numerical integration.
+//       Publications of the Research Institute for Mathematical Sciences, 9(3):721-741
+//
+func TanhSinh(f fun.Ss, a, b, tol float64) (res float64, err error) {
+
+	// check
+	if tol <= 0 {
+		return 0, chk.Err("tolerance must be positive. tol = %g is invalid", tol)
+	}
+	if math.IsInf(a, 0) || math.IsInf(b, 0) || math.IsNaN(a) || math.IsNaN(b) {
+		return 0, chk.Err("the limits of integration must be finite. a = %g and b = %g are invalid", a, b)
+	}
+	if a == b {
+		return 0, nil
+	}
+
+	// constants
+	const tmax = 6.2 // exp(-2 π/2 sinh(tmax)) underflows
+	const maxLevel = 12
+	c, d := 0.5*(a+b), 0.5*(b-a)
+
+	// sum of the terms f(x(t)) w(t) + f(x(-t)) w(-t) for t > 0; the points that coincide with a
+	// or b (in floating-point arithmetic) are skipped and ok = false if both are skipped
+	pair := func(t float64) (sum float64, ok bool) {
+		u := 0.5 * math.Pi * math.Sinh(t)
+		q := math.Exp(-2 * u)
+		δ := 2 * q / (1 + q) // 1 - tanh(u)
+		w := d * 0.5 * math.Pi * math.Cosh(t) * 4 * q / ((1 + q) * (1 + q))
+		if xl := a + d*δ; xl != a {
+			sum += w * f(xl)
+			ok = true
+		}
+		if xr := b - d*δ; xr != b {
+			sum += w * f(xr)
+			ok = true
+		}
+		return
+	}
+
+	// first level: h = 1
+	h := 1.0
+	sum := d * 0.5 * math.Pi * f(c)
+	for t := h; t <= tmax; t += h {
+		s, ok := pair(t)
+		if !ok {
+			break
+		}
+		sum += s
+	}
+	res = h * sum
+
+	// refine
+	for level := 1; level <= maxLevel; level++ {
+		h /= 2
+		for k := 1; float64(k)*h <= tmax; k += 2 {
+			s, ok := pair(float64(k) * h)
+			if !ok {
+				break
+			}
+			sum += s
+		}
+		prev := res
+		res = h * sum
+		if math.IsNaN(res) || math.IsInf(res, 0) {
+			return res, chk.Err("the integral estimate is not finite. res = %g", res)
+		}
+		if math.Abs(res-prev) <= tol {
+			return
+		}
+	}
+	return res, chk.Err("maximum number of levels = %d reached before the tolerance = %g was met", maxLevel, tol)
+}
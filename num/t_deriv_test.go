@@ -334,3 +334,56 @@ func TestDeriv04(tst *testing.T) {
 		}
 	}
 }
+
+func TestDeriv05(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("Deriv05. Derivative and DerivativeN with Richardson extrapolation")
+
+	// first derivative of sin
+	xs := []float64{-3, -1, 0, 0.5, 1.2, 2, 10, 100}
+	maxErr, maxErrFwd := 0.0, 0.0
+	for _, x := range xs {
+		d := Derivative(math.Sin, x, 0)
+		chk.Float64(tst, io.Sf("d(sin)/dx @ %g", x), 1e-10, d, math.Cos(x))
+		maxErr = math.Max(maxErr, math.Abs(d-math.Cos(x)))
+		h := 1e-6
+		dfwd := (math.Sin(x+h) - math.Sin(x)) / h
+		maxErrFwd = math.Max(maxErrFwd, math.Abs(dfwd-math.Cos(x)))
+	}
+	io.Pforan("max error: Richardson = %v  forward difference = %v\n", maxErr, maxErrFwd)
+	if maxErr > 1e-3*maxErrFwd {
+		tst.Errorf("Richardson extrapolation should be much more accurate than forward differences\n")
+		return
+	}
+
+	// given step
+	chk.Float64(tst, "d(exp)/dx @ 1 (h=0.05)", 1e-11, Derivative(math.Exp, 1, 0.05), math.E)
+
+	// higher derivatives of sin
+	io.Pl()
+	ders := []fun.Ss{
+		math.Sin,
+		math.Cos,
+		func(x float64) float64 { return -math.Sin(x) },
+		func(x float64) float64 { return -math.Cos(x) },
+		math.Sin,
+	}
+	tols := []float64{1e-17, 1e-11, 1e-11, 1e-9, 1e-8}
+	for order := 0; order <= 4; order++ {
+		maxErr = 0
+		for _, x := range xs {
+			d := DerivativeN(math.Sin, x, 0, order)
+			maxErr = math.Max(maxErr, math.Abs(d-ders[order](x)))
+		}
+		io.Pforan("order = %d  max error = %v\n", order, maxErr)
+		if maxErr > tols[order] {
+			tst.Errorf("order = %d: max error = %g is too large\n", order, maxErr)
+			return
+		}
+	}
+
+	// polynomial
+	p := func(x float64) float64 { return x*x*x*x - 2*x*x*x + x }
+	chk.Float64(tst, "d³p/dx³ @ 1.5", 1e-7, DerivativeN(p, 1.5, 0, 3), 24*1.5-12)
+}
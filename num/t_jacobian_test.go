@@ -10,6 +10,7 @@ import (
 
 	"github.com/cpmech/gosl/chk"
 	"github.com/cpmech/gosl/la"
+	"github.com/cpmech/gosl/utl"
 )
 
 const (
@@ -108,3 +109,60 @@ func TestJacobian03(tst *testing.T) {
 	x := []float64{0.5, 0.5}
 	CompareJacDense(tst, ffcn, Jfcn, x, 1e-7)
 }
+
+func TestJacobian04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("TestJacobian 04 (JacobianFD)")
+
+	// square system
+	f := func(x []float64) []float64 {
+		return []float64{x[0]*x[0] + x[1], x[0] * x[1]}
+	}
+	for _, x := range [][]float64{{0, 0}, {1, 2}, {-3, 0.5}, {100, -20}} {
+		J, err := JacobianFD(f, x, 0)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		Jana := [][]float64{
+			{2 * x[0], 1},
+			{x[1], x[0]},
+		}
+		chk.Deep2(tst, "J", 1e-8*utl.Max(1, math.Abs(x[0])), J, Jana)
+	}
+
+	// non-square system (m=3, n=2) with given step
+	g := func(x []float64) []float64 {
+		return []float64{sin(x[0]) * x[1], math.Exp(x[1]), x[0] - x[1]}
+	}
+	x := []float64{0.3, -0.7}
+	J, err := JacobianFD(g, x, 1e-5)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Deep2(tst, "J", 1e-9, J, [][]float64{
+		{cos(x[0]) * x[1], sin(x[0])},
+		{0, math.Exp(x[1])},
+		{1, -1},
+	})
+	chk.Array(tst, "x is unchanged", 1e-17, x, []float64{0.3, -0.7})
+
+	// dimension mismatch
+	bad := func(x []float64) []float64 {
+		if x[1] > 1 {
+			return []float64{x[0], x[1], 0}
+		}
+		return []float64{x[0], x[1]}
+	}
+	_, err = JacobianFD(bad, []float64{0, 1}, 0.1)
+	if err == nil {
+		tst.Errorf("dimension mismatch should have caused an error\n")
+		return
+	}
+	if _, err = JacobianFD(f, nil, 0); err == nil {
+		tst.Errorf("empty x should have caused an error\n")
+		return
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package num
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_adaptiveSimpson01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("adaptiveSimpson01. smooth integrand")
+
+	for _, tol := range []float64{1e-6, 1e-10, 1e-13} {
+		res, err := AdaptiveSimpson(math.Sin, 0, math.Pi, tol, 50)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		chk.Float64(tst, io.Sf("∫sin(x) in [0,π] (tol=%g)", tol), tol, res, 2)
+	}
+
+	// polynomials up to degree 3 are integrated exactly in one step
+	nEval := 0
+	f := func(x float64) float64 { nEval++; return x*x*x - 2*x + 1 }
+	res, err := AdaptiveSimpson(f, -1, 2, 1e-14, 1)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Float64(tst, "∫(x³-2x+1) in [-1,2]", 1e-14, res, 3.75)
+	chk.Int(tst, "number of evaluations", nEval, 5)
+}
+
+func Test_adaptiveSimpson02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("adaptiveSimpson02. sharp peak")
+
+	// integrand with a sharp peak at x = 0.3
+	ε := 1e-6
+	nEval := 0
+	f := func(x float64) float64 {
+		nEval++
+		return 1 / (ε + (x-0.3)*(x-0.3))
+	}
+	ana := (math.Atan(0.7/math.Sqrt(ε)) + math.Atan(0.3/math.Sqrt(ε))) / math.Sqrt(ε)
+
+	// adaptive
+	tol := 1e-6
+	res, err := AdaptiveSimpson(f, 0, 1, tol, 50)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	io.Pforan("adaptive: nEval = %d\n", nEval)
+	chk.Float64(tst, "∫f (adaptive)", tol, res, ana)
+	nAdaptive := nEval
+
+	// uniform Simpson's rule with the same accuracy
+	n := 2
+	for math.Abs(QuadDiscreteSimpsonRF(0, 1, n, f)-ana) > tol {
+		n *= 2
+	}
+	io.Pforan("uniform:  nEval = %d\n", n+1)
+	if nAdaptive >= n+1 {
+		tst.Errorf("adaptive Simpson should require fewer evaluations: %d ≥ %d\n", nAdaptive, n+1)
+		return
+	}
+
+	// maximum depth
+	_, err = AdaptiveSimpson(f, 0, 1, tol, 5)
+	if err == nil {
+		tst.Errorf("maxDepth = 5 should have caused an error\n")
+		return
+	}
+	io.Pforan("%v\n", err)
+
+	// invalid input
+	if _, err = AdaptiveSimpson(f, 0, 1, 0, 10); err == nil {
+		tst.Errorf("tol = 0 should have caused an error\n")
+		return
+	}
+	if _, err = AdaptiveSimpson(f, 0, 1, tol, 0); err == nil {
+		tst.Errorf("maxDepth = 0 should have caused an error\n")
+		return
+	}
+}
@@ -62,6 +62,123 @@ func Test_DiscTrapz02(tst *testing.T) {
 	chk.Float64(tst, "A1", 1e-15, A1, Acor)
 }
 
+func Test_DiscTrapz03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("DiscTrapz03. Trapz and TrapzX with sampled data")
+
+	// line: exact
+	n := 11
+	x := utl.LinSpace(1, 3, n)
+	y := make([]float64, n)
+	for i := 0; i < n; i++ {
+		y[i] = 2*x[i] + 1
+	}
+	dx := x[1] - x[0]
+	A, err := TrapzX(x, y)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Float64(tst, "line: Trapz ", 1e-14, Trapz(y, dx), 10)
+	chk.Float64(tst, "line: TrapzX", 1e-14, A, 10)
+
+	// parabola y = x² on [0, 1]: the error of the trapezoidal rule is h²/6 (uniform) or Σ hᵢ³/6
+	for _, n := range []int{5, 21, 101} {
+		x = utl.LinSpace(0, 1, n)
+		y = make([]float64, n)
+		for i := 0; i < n; i++ {
+			y[i] = x[i] * x[i]
+		}
+		h := x[1] - x[0]
+		chk.Float64(tst, io.Sf("n=%3d: parabola: Trapz ", n), 1e-14, Trapz(y, h), 1.0/3.0+h*h/6.0)
+	}
+	x = []float64{0, 0.1, 0.15, 0.4, 0.7, 0.75, 1}
+	y = make([]float64, len(x))
+	cubes := 0.0
+	for i := 0; i < len(x); i++ {
+		y[i] = x[i] * x[i]
+		if i > 0 {
+			cubes += math.Pow(x[i]-x[i-1], 3)
+		}
+	}
+	A, err = TrapzX(x, y)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Float64(tst, "non-uniform parabola: TrapzX", 1e-15, A, 1.0/3.0+cubes/6.0)
+
+	// special cases
+	chk.Float64(tst, "one sample", 1e-17, Trapz([]float64{3}, 0.1), 0)
+	if _, err = TrapzX([]float64{0, 1, 2}, []float64{1, 2}); err == nil {
+		tst.Errorf("mismatched lengths of x and y should have caused an error\n")
+		return
+	}
+}
+
+func Test_DiscCumTrapz01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("DiscCumTrapz01. cumulative trapezoidal rule")
+
+	// constant ⇒ linear ramp (exact)
+	n := 9
+	x := utl.LinSpace(0, 2, n)
+	y := utl.Vals(n, 3)
+	dx := x[1] - x[0]
+	ramp := make([]float64, n)
+	for i := 0; i < n; i++ {
+		ramp[i] = 3 * x[i]
+	}
+	c, err := CumTrapzX(x, y)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "constant: CumTrapz ", 1e-15, CumTrapz(y, dx), ramp)
+	chk.Array(tst, "constant: CumTrapzX", 1e-15, c, ramp)
+
+	// cos ⇒ sin; the error is O(h²)
+	for _, n := range []int{11, 101, 1001} {
+		x = utl.LinSpace(0, math.Pi, n)
+		y = make([]float64, n)
+		s := make([]float64, n)
+		for i := 0; i < n; i++ {
+			y[i] = math.Cos(x[i])
+			s[i] = math.Sin(x[i])
+		}
+		h := x[1] - x[0]
+		c = CumTrapz(y, h)
+		chk.Int(tst, "len(c)", len(c), n)
+		chk.Float64(tst, io.Sf("n=%4d: c[0]", n), 1e-17, c[0], 0)
+		chk.Float64(tst, io.Sf("n=%4d: c[n-1] = Trapz", n), 1e-14, c[n-1], Trapz(y, h))
+		chk.Array(tst, io.Sf("n=%4d: cumtrapz(cos) = sin", n), 0.1*h*h, c, s)
+	}
+
+	// non-uniform points
+	x = []float64{0, 0.2, 0.25, 0.7, 1.1, 1.5, 1.55, 2}
+	y = make([]float64, len(x))
+	s := make([]float64, len(x))
+	for i := 0; i < len(x); i++ {
+		y[i] = math.Cos(x[i])
+		s[i] = math.Sin(x[i])
+	}
+	c, err = CumTrapzX(x, y)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "non-uniform: cumtrapz(cos) = sin", 0.02, c, s)
+
+	// special cases
+	chk.Array(tst, "empty", 1e-17, CumTrapz(nil, 1), nil)
+	if _, err = CumTrapzX([]float64{0, 1}, []float64{1}); err == nil {
+		tst.Errorf("mismatched lengths of x and y should have caused an error\n")
+		return
+	}
+}
+
 func Test_Disc2dInteg01(tst *testing.T) {
 
 	//verbose()
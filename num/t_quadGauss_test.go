@@ -45,3 +45,192 @@ func Test_gaussLegXW01(tst *testing.T) {
 	chk.Array(tst, "xJ", 1e-15, xJ, xRef)
 	chk.Array(tst, "wJ", 1e-14, wJ, wRef)
 }
+
+func Test_gaussLegendre01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("gaussLegendre01. Gauss-Legendre rule with n points")
+
+	// compare with GaussJacobiXW (α = β = 0 gives the Legendre rule)
+	for _, n := range []int{1, 2, 3, 4, 7, 10, 20, 51} {
+		x, w, err := GaussLegendre(n)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		xRef, wRef := GaussJacobiXW(0, 0, n)
+		chk.Array(tst, io.Sf("n=%2d: nodes", n), 1e-14, x, xRef)
+		chk.Array(tst, io.Sf("n=%2d: weights", n), 1e-13, w, wRef)
+
+		// GaussLegendreXW scales the rule to [x1, x2]
+		xs, ws := GaussLegendreXW(1, 4, n)
+		for i := 0; i < n; i++ {
+			chk.Float64(tst, io.Sf("n=%2d: x%d in [1,4]", n, i), 1e-15, xs[i], 2.5+1.5*x[i])
+			chk.Float64(tst, io.Sf("n=%2d: w%d in [1,4]", n, i), 1e-15, ws[i], 1.5*w[i])
+		}
+		sum := 0.0
+		for _, v := range w {
+			sum += v
+		}
+		chk.Float64(tst, io.Sf("n=%2d: Σw", n), 1e-14, sum, 2)
+	}
+
+	// known values
+	x, w, _ := GaussLegendre(3)
+	chk.Array(tst, "n=3: nodes", 1e-15, x, []float64{-math.Sqrt(0.6), 0, math.Sqrt(0.6)})
+	chk.Array(tst, "n=3: weights", 1e-15, w, []float64{5.0 / 9.0, 8.0 / 9.0, 5.0 / 9.0})
+
+	// error
+	_, _, err := GaussLegendre(0)
+	if err == nil {
+		tst.Errorf("n=0 should have caused an error\n")
+		return
+	}
+}
+
+func Test_integrateGL01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("integrateGL01. exact for polynomials of degree 2n-1")
+
+	a, b := -0.5, 1.5
+	for _, n := range []int{1, 2, 3, 5, 8} {
+		for k := 0; k <= 2*n-1; k++ {
+			K := float64(k)
+			f := func(x float64) float64 { return math.Pow(x, K) }
+			ana := (math.Pow(b, K+1) - math.Pow(a, K+1)) / (K + 1)
+			chk.Float64(tst, io.Sf("n=%d: ∫x^%d", n, k), 1e-14*math.Max(1, ana), IntegrateGL(f, a, b, n), ana)
+		}
+
+		// degree 2n is not integrated exactly
+		K := float64(2 * n)
+		f := func(x float64) float64 { return math.Pow(x, K) }
+		ana := (math.Pow(b, K+1) - math.Pow(a, K+1)) / (K + 1)
+		if math.Abs(IntegrateGL(f, a, b, n)-ana) < 1e-10 {
+			tst.Errorf("n=%d: degree %d should not be integrated exactly\n", n, 2*n)
+			return
+		}
+	}
+
+	// smooth function
+	io.Pl()
+	chk.Float64(tst, "∫exp(x) in [0,1]", 1e-15, IntegrateGL(math.Exp, 0, 1, 10), math.E-1)
+	chk.Float64(tst, "∫cos(x) in [0,π/2]", 1e-15, IntegrateGL(math.Cos, 0, math.Pi/2, 12), 1)
+}
+
+func Test_gaussHermite01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("gaussHermite01. Gauss-Hermite rule with n points")
+
+	// known values
+	x, w, err := GaussHermite(3)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "n=3: nodes", 1e-15, x, []float64{-math.Sqrt(1.5), 0, math.Sqrt(1.5)})
+	chk.Array(tst, "n=3: weights", 1e-15, w, []float64{math.SqrtPi / 6, 2 * math.SqrtPi / 3, math.SqrtPi / 6})
+
+	// ∫ exp(-x²) xᵏ dx = Γ((k+1)/2) for even k and 0 for odd k
+	for _, n := range []int{1, 2, 3, 5, 8, 12, 20} {
+		x, w, err = GaussHermite(n)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		for k := 0; k <= 2*n-1; k++ {
+			res, mag := 0.0, 0.0 // mag = Σ w |x|ᵏ is the scale of the round-off errors
+			for i := range x {
+				res += w[i] * math.Pow(x[i], float64(k))
+				mag += w[i] * math.Pow(math.Abs(x[i]), float64(k))
+			}
+			ana := 0.0
+			if k%2 == 0 {
+				ana = math.Gamma(float64(k+1) / 2)
+			}
+			chk.Float64(tst, io.Sf("n=%2d: ∫exp(-x²) x^%d", n, k), 1e-13*math.Max(1, mag), res, ana)
+		}
+	}
+
+	// large n: ∫ exp(-x²) dx = √π and ∫ exp(-x²) cos(x) dx = √π exp(-1/4)
+	for _, n := range []int{50, 100} {
+		x, w, err = GaussHermite(n)
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		sum, cos := 0.0, 0.0
+		for i := range x {
+			sum += w[i]
+			cos += w[i] * math.Cos(x[i])
+		}
+		chk.Float64(tst, io.Sf("n=%d: ∫exp(-x²)", n), 1e-14, sum, math.SqrtPi)
+		chk.Float64(tst, io.Sf("n=%d: ∫exp(-x²) cos(x)", n), 1e-14, cos, math.SqrtPi*math.Exp(-0.25))
+	}
+
+	// error
+	_, _, err = GaussHermite(0)
+	if err == nil {
+		tst.Errorf("n=0 should have caused an error\n")
+		return
+	}
+}
+
+func Test_gaussLaguerre01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("gaussLaguerre01. Gauss-Laguerre rule with n points")
+
+	// known values (α = 0, n = 2): x = 2 ∓ √2 and w = (2 ± √2)/4
+	x, w, err := GaussLaguerre(2, 0)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Array(tst, "n=2: nodes", 1e-15, x, []float64{2 - math.Sqrt2, 2 + math.Sqrt2})
+	chk.Array(tst, "n=2: weights", 1e-15, w, []float64{(2 + math.Sqrt2) / 4, (2 - math.Sqrt2) / 4})
+
+	// ∫ xᵅ exp(-x) xᵏ dx = Γ(k+α+1)
+	for _, alpha := range []float64{0, 0.5, -0.5, 2} {
+		for _, n := range []int{1, 2, 3, 5, 8, 12} {
+			x, w, err = GaussLaguerre(n, alpha)
+			if err != nil {
+				tst.Errorf("%v\n", err)
+				return
+			}
+			for k := 0; k <= 2*n-1; k++ {
+				res := 0.0
+				for i := range x {
+					res += w[i] * math.Pow(x[i], float64(k))
+				}
+				ana := math.Gamma(float64(k) + alpha + 1)
+				chk.Float64(tst, io.Sf("α=%g n=%2d: ∫xᵅexp(-x) x^%d", alpha, n, k), 1e-12*ana, res, ana)
+			}
+		}
+	}
+
+	// large n: ∫ exp(-x) sin(x) dx = 1/2
+	x, w, err = GaussLaguerre(60, 0)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	res := 0.0
+	for i := range x {
+		res += w[i] * math.Sin(x[i])
+	}
+	chk.Float64(tst, "n=60: ∫exp(-x) sin(x)", 1e-13, res, 0.5)
+
+	// errors
+	_, _, err = GaussLaguerre(0, 0)
+	if err == nil {
+		tst.Errorf("n=0 should have caused an error\n")
+		return
+	}
+	_, _, err = GaussLaguerre(3, -1)
+	if err == nil {
+		tst.Errorf("alpha=-1 should have caused an error\n")
+		return
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package num
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_romberg01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("romberg01. Romberg's method")
+
+	// ∫ 1/(1+x²) in [0,1] = π/4
+	f := func(x float64) float64 { return 1 / (1 + x*x) }
+	res, err := Romberg(f, 0, 1, 20, 1e-15)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	io.Pforan("res = %v  error = %v\n", res, math.Abs(res-math.Pi/4))
+	chk.Float64(tst, "∫1/(1+x²) in [0,1]", 1e-15, res, math.Pi/4)
+
+	// other integrands
+	res, err = Romberg(math.Exp, -1, 2, 20, 1e-13)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Float64(tst, "∫exp(x) in [-1,2]", 1e-13, res, math.Exp(2)-math.Exp(-1))
+
+	// cubic: exact after the Simpson column
+	res, err = Romberg(func(x float64) float64 { return x*x*x - x }, 0, 2, 5, 1e-15)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Float64(tst, "∫(x³-x) in [0,2]", 1e-15, res, 2)
+
+	// no convergence
+	_, err = Romberg(math.Sqrt, 0, 1, 4, 1e-15)
+	if err == nil {
+		tst.Errorf("maxIter = 4 should have caused an error\n")
+		return
+	}
+	io.Pforan("%v\n", err)
+	if _, err = Romberg(f, 0, 1, 1, 1e-10); err == nil {
+		tst.Errorf("maxIter = 1 should have caused an error\n")
+		return
+	}
+}
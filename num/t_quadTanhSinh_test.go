@@ -0,0 +1,79 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package num
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_tanhSinh01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("tanhSinh01. endpoint singularities")
+
+	for _, c := range []struct {
+		name string
+		f    func(x float64) float64
+		a, b float64
+		ana  float64
+	}{
+		{"1/√x in [0,1]", func(x float64) float64 { return 1 / math.Sqrt(x) }, 0, 1, 2},
+		{"ln(x) in [0,1]", math.Log, 0, 1, -1},
+		{"x^(-0.9) in [0,1]", func(x float64) float64 { return math.Pow(x, -0.9) }, 0, 1, 10},
+		{"ln(x)/√x in [0,4]", func(x float64) float64 { return math.Log(x) / math.Sqrt(x) }, 0, 4, 8*math.Log(2) - 8},
+		{"sin(x) in [0,π]", math.Sin, 0, math.Pi, 2},
+		{"1/√x in [1,0]", func(x float64) float64 { return 1 / math.Sqrt(x) }, 1, 0, -2},
+	} {
+		nEval := 0
+		g := func(x float64) float64 { nEval++; return c.f(x) }
+		res, err := TanhSinh(g, c.a, c.b, 1e-12)
+		if err != nil {
+			tst.Errorf("%s: %v\n", c.name, err)
+			return
+		}
+		io.Pforan("%-18s: number of evaluations = %d\n", c.name, nEval)
+		chk.Float64(tst, c.name, 1e-12*math.Max(1, math.Abs(c.ana)), res, c.ana)
+	}
+
+	// the Gauss-Legendre rule converges slowly for singular integrands
+	io.Pl()
+	f := func(x float64) float64 { return 1 / math.Sqrt(x) }
+	resGL := IntegrateGL(f, 0, 1, 20)
+	io.Pforan("Gauss-Legendre (20 points): ∫1/√x in [0,1] = %v\n", resGL)
+	if math.Abs(resGL-2) < 1e-2 {
+		tst.Errorf("the 20-point Gauss-Legendre rule should not be accurate for 1/√x\n")
+		return
+	}
+}
+
+func Test_tanhSinh02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("tanhSinh02. special cases and errors")
+
+	res, err := TanhSinh(math.Exp, 2, 2, 1e-10)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Float64(tst, "∫ in [2,2]", 1e-17, res, 0)
+
+	if _, err = TanhSinh(math.Exp, 0, 1, 0); err == nil {
+		tst.Errorf("tol = 0 should have caused an error\n")
+		return
+	}
+	if _, err = TanhSinh(math.Exp, 0, math.Inf(1), 1e-10); err == nil {
+		tst.Errorf("infinite limit should have caused an error\n")
+		return
+	}
+	if _, err = TanhSinh(func(x float64) float64 { return 1 / x }, 0, 1, 1e-10); err == nil {
+		tst.Errorf("non-integrable singularity should have caused an error\n")
+		return
+	}
+}
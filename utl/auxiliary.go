@@ -4,7 +4,11 @@
 
 package utl
 
-import "math"
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
 
 // BestSquare finds the best square for given size=Nrows * Ncolumns
 func BestSquare(size int) (nrow, ncol int) {
@@ -86,6 +90,35 @@ func IsPowerOfTwo(n int) bool {
 	return n&(n-1) == 0
 }
 
+// NextPowerOfTwo returns the smallest power of 2 that is greater than or equal to n ≥ 1
+//   NOTE: panics if n < 1 or if the result would overflow int
+func NextPowerOfTwo(n int) int {
+	if n < 1 {
+		chk.Panic("NextPowerOfTwo requires n ≥ 1. n = %d is invalid", n)
+	}
+	if n > math.MaxInt/2+1 {
+		chk.Panic("NextPowerOfTwo(%d) would overflow int", n)
+	}
+	m := 1
+	for m < n {
+		m <<= 1
+	}
+	return m
+}
+
+// PrevPowerOfTwo returns the largest power of 2 that is smaller than or equal to n ≥ 1
+//   NOTE: panics if n < 1
+func PrevPowerOfTwo(n int) int {
+	if n < 1 {
+		chk.Panic("PrevPowerOfTwo requires n ≥ 1. n = %d is invalid", n)
+	}
+	m := 1
+	for m <= n/2 {
+		m <<= 1
+	}
+	return m
+}
+
 // Swap swaps two float64 numbers
 func Swap(a, b *float64) {
 	*a, *b = *b, *a
@@ -5,6 +5,7 @@
 package utl
 
 import (
+	"math"
 	"testing"
 
 	"github.com/cpmech/gosl/chk"
@@ -96,6 +97,52 @@ func TestAuxFuncs01(tst *testing.T) {
 	}
 }
 
+func TestPowerOfTwo01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("PowerOfTwo01. NextPowerOfTwo and PrevPowerOfTwo")
+
+	largest := math.MaxInt/2 + 1 // largest power of 2 representable by int
+	for _, c := range []struct{ n, next, prev int }{
+		{1, 1, 1},
+		{2, 2, 2},
+		{3, 4, 2},
+		{4, 4, 4},
+		{5, 8, 4},
+		{1023, 1024, 512},
+		{1024, 1024, 1024},
+		{1025, 2048, 1024},
+		{largest - 1, largest, largest / 2},
+		{largest, largest, largest},
+		{math.MaxInt, -1, largest},
+	} {
+		if c.next > 0 {
+			chk.Int(tst, io.Sf("NextPowerOfTwo(%d)", c.n), NextPowerOfTwo(c.n), c.next)
+		}
+		chk.Int(tst, io.Sf("PrevPowerOfTwo(%d)", c.n), PrevPowerOfTwo(c.n), c.prev)
+	}
+
+	// invalid input
+	for _, n := range []int{0, -1, largest + 1, math.MaxInt} {
+		func() {
+			defer func() {
+				if err := recover(); err == nil {
+					tst.Errorf("NextPowerOfTwo(%d) should have panicked\n", n)
+				}
+			}()
+			NextPowerOfTwo(n)
+		}()
+	}
+	func() {
+		defer func() {
+			if err := recover(); err == nil {
+				tst.Errorf("PrevPowerOfTwo(0) should have panicked\n")
+			}
+		}()
+		PrevPowerOfTwo(0)
+	}()
+}
+
 func TestMinMax01(tst *testing.T) {
 
 	//chk.Verbose = true